@@ -0,0 +1,373 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+)
+
+// Bootstrap seeds a new replica with the data of a source and hands it over to the stream pattern without gaps. The
+// last entry of the stream is taken before the snapshot, the entries appended while the snapshot runs are replayed,
+// and the consumer group of the replica is created where the replay ended
+type Bootstrap struct {
+	source   IMilvusSnapshotClientInterface
+	target   IMilvusClientInterface
+	redis    *redis.Client
+	redisCli *RedisClient
+	channel  string
+	opts     []Option
+	o        *options
+	syncer   *syncer
+}
+
+// NewBootstrap copies source into target and follows the stream channel, a route of target 0 limits both and a name
+// mapping of target 0 renames both. The consumer group of the options must not exist yet, it only feeds the new
+// target. Unless a checkpoint store is given, the checkpoints of the target are kept under the consumer group so they
+// do not mix with the ones of the other targets
+func NewBootstrap(source IMilvusSnapshotClientInterface, target IMilvusClientInterface, redis *redis.Client, channel string, opts ...Option) *Bootstrap {
+	o := newOptions(opts...)
+	if o.checkpoint == nil {
+		o.checkpoint = NewRedisCheckpointStore(redis, fmt.Sprintf("%s:%s", DefaultCheckpointKey, o.consumerGroup))
+		opts = append(opts, WithCheckpointStore(o.checkpoint))
+	}
+
+	return &Bootstrap{
+		source:   source,
+		target:   target,
+		redis:    redis,
+		redisCli: NewRedisClient(redis),
+		channel:  channel,
+		opts:     opts,
+		o:        o,
+		syncer:   newSyncer([]IMilvusClientInterface{target}, o),
+	}
+}
+
+// Run snapshots the source into the target, replays the entries appended meanwhile and returns the broker that
+// streams the channel to the target from there on, the caller starts it with the stream pattern
+func (b *Bootstrap) Run(ctx context.Context) (*RedisBroker, error) {
+	position, err := b.position(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read the position of stream %v is failed with err %v", b.channel, err)
+	}
+
+	logrus.Infof("bootstrap the target from the snapshot, stream %v is replayed after entry %v", b.channel, position)
+
+	err = b.snapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	position, err = b.catchUp(ctx, position)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.redisCli.XGroupCreateMkStream(ctx, b.channel, b.o.consumerGroup, position)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "BUSYGROUP") {
+			return nil, fmt.Errorf("the consumer group %v of stream %v already exists, the bootstrap needs a new one", b.o.consumerGroup, b.channel)
+		}
+
+		return nil, err
+	}
+
+	logrus.Infof("the target is bootstrapped, consumer group %v streams %v after entry %v", b.o.consumerGroup, b.channel, position)
+
+	return NewRedisBroker(b.redis, []IMilvusClientInterface{b.target}, b.opts...), nil
+}
+
+// position returns the id of the last entry of the stream, or 0-0 when it is empty
+func (b *Bootstrap) position(ctx context.Context) (string, error) {
+	messages, err := b.redisCli.XRevRangeN(ctx, b.channel, "+", "-", 1)
+	if err != nil {
+		return "", err
+	}
+
+	if len(messages) == 0 {
+		return "0-0", nil
+	}
+
+	return messages[0].ID, nil
+}
+
+func (b *Bootstrap) snapshot(ctx context.Context) error {
+	collections, err := b.source.ListCollections()
+	if err != nil {
+		return fmt.Errorf("list collections of the source is failed with err %w", err)
+	}
+
+	for _, collectionName := range collections {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// the route of the target selects what is copied as it selects what is streamed
+		if !b.syncer.routed(&MessageCDC{Action: CreateCollection, CollectionName: collectionName}, 0) {
+			continue
+		}
+
+		err = b.copyCollection(ctx, collectionName)
+		if err != nil {
+			return fmt.Errorf("copy collection %v is failed with err %w", collectionName, err)
+		}
+	}
+
+	return nil
+}
+
+// copyCollection creates the collection and its partitions, copies the entities segment by segment and builds the
+// index last so the inserts are not slowed down by it. A collection that already exists on the target is left by an
+// earlier run, its entities are deleted before they are inserted again so the run can be repeated
+func (b *Bootstrap) copyCollection(ctx context.Context, collectionName string) error {
+	collection, err := b.source.GetCollectionInfo(collectionName)
+	if err != nil {
+		return err
+	}
+
+	// the target gets the names of its name mapping, the source and the routes keep the ones of the source
+	mapping := b.syncer.mappings[0]
+
+	var rerun bool
+	err = b.target.CreateCollection(mapping.Collection(collectionName), collection.Dimension, collection.IndexFileSize, milvus.MetricType(collection.MetricType))
+	if isAlreadyExists(err) {
+		logrus.Infof("collection %v already exists on the target, its entities are replaced", collectionName)
+		rerun = true
+	} else if err != nil {
+		return err
+	}
+
+	partitions, err := b.source.ListPartitions(collectionName)
+	if err != nil {
+		return err
+	}
+
+	for _, partitionTag := range partitions {
+		if partitionTag == DefaultPartitionTag ||
+			!b.syncer.routed(&MessageCDC{Action: CreatePartition, CollectionName: collectionName, PartitionTag: partitionTag}, 0) {
+			continue
+		}
+
+		err = b.target.CreatePartition(mapping.Collection(collectionName), mapping.Partition(collectionName, partitionTag))
+		if err != nil && !isAlreadyExists(err) {
+			return err
+		}
+	}
+
+	stats, err := b.source.ShowCollectionInfo(collectionName)
+	if err != nil {
+		return err
+	}
+
+	var copied int
+	for _, partition := range stats.Partitions {
+		partitionTag := partition.Tag
+		if partitionTag == DefaultPartitionTag {
+			partitionTag = ""
+		}
+
+		if !b.syncer.routed(&MessageCDC{Action: Insert, CollectionName: collectionName, PartitionTag: partitionTag}, 0) {
+			continue
+		}
+
+		for _, segment := range partition.Segments {
+			ids, err := b.source.ListIDInSegment(collectionName, segment.Name)
+			if err != nil {
+				return err
+			}
+
+			for start := 0; start < len(ids); start += b.o.snapshotBatch {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				end := min(start+b.o.snapshotBatch, len(ids))
+
+				n, err := b.copyEntities(ctx, collectionName, partitionTag, ids[start:end], rerun)
+				if err != nil {
+					return err
+				}
+
+				copied += n
+			}
+		}
+	}
+
+	index, err := b.source.GetIndexInfo(collectionName)
+	if err != nil {
+		return err
+	}
+
+	if index.IndexType != milvus.FLAT && index.IndexType != milvus.INVALID {
+		nList, err := parseNList(index.ExtraParams)
+		if err != nil {
+			return err
+		}
+
+		err = b.target.CreateIndex(mapping.Collection(collectionName), nList, index.IndexType)
+		if err != nil {
+			return err
+		}
+	}
+
+	logrus.Infof("collection %v is copied with %d entities", collectionName, copied)
+
+	return nil
+}
+
+// copyEntities inserts the entities of ids that still exist in the source, it returns how many were inserted. On a
+// rerun the ids are deleted first, the target may already hold them
+func (b *Bootstrap) copyEntities(ctx context.Context, collectionName, partitionTag string, ids []int64, rerun bool) (int, error) {
+	entities, err := b.source.GetEntityByID(collectionName, partitionTag, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(entities) != len(ids) {
+		return 0, fmt.Errorf("the source returned %d entities for %d ids", len(entities), len(ids))
+	}
+
+	var (
+		kept    = make([]int64, 0, len(entities))
+		vectors = make([]string, 0, len(entities))
+	)
+
+	for i, entity := range entities {
+		if len(entity.BinaryData) > 0 {
+			return 0, fmt.Errorf("the entity %d has a binary vector, binary vectors are not supported", ids[i])
+		}
+
+		// entities deleted since the segment was listed have no data
+		if len(entity.FloatData) == 0 {
+			continue
+		}
+
+		kept = append(kept, ids[i])
+		vectors = append(vectors, EncodeVector(entity.FloatData))
+	}
+
+	if len(kept) == 0 {
+		return 0, nil
+	}
+
+	mapping := b.syncer.mappings[0]
+
+	if rerun {
+		err = b.syncer.retryPolicy.do(ctx, func() error {
+			return b.target.DeleteBatch(mapping.Collection(collectionName), mapping.Partition(collectionName, partitionTag), kept)
+		})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = b.syncer.retryPolicy.do(ctx, func() error {
+		return b.target.InsertBatch(vectors, mapping.Collection(collectionName), mapping.Partition(collectionName, partitionTag), kept)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(kept), nil
+}
+
+// catchUp replays the entries appended after position and returns the id of the last one. The snapshot may already
+// hold what an entry inserts, so inserts delete their ids first and are not applied twice, unless the checkpoint says
+// the insert is applied and it is skipped. The replay stops at the first entry the target fails, the consumer group
+// must not start after an entry that is not applied
+func (b *Bootstrap) catchUp(ctx context.Context, position string) (string, error) {
+	var replayed int
+	for {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+
+		messages, err := b.redisCli.XRangeN(ctx, b.channel, "("+position, "+", b.o.readCount)
+		if err != nil {
+			return "", fmt.Errorf("read stream %v after entry %v is failed with err %v", b.channel, position, err)
+		}
+
+		if len(messages) == 0 {
+			logrus.Infof("%d entries of stream %v are replayed", replayed, b.channel)
+			return position, nil
+		}
+
+		for _, message := range messages {
+			payload, ok := message.Values[StreamPayloadField].(string)
+			if !ok {
+				logrus.Errorf("stream entry %v has no %v field, skip it", message.ID, StreamPayloadField)
+			} else {
+				err = b.replay(ctx, message.ID, payload)
+				if err != nil {
+					return "", err
+				}
+			}
+
+			position = message.ID
+			replayed++
+		}
+	}
+}
+
+// replay applies a stream entry to the target, an invalid entry is skipped as the stream pattern would bury it
+func (b *Bootstrap) replay(ctx context.Context, id, payload string) error {
+	message, err := b.syncer.decode(payload)
+	if err != nil {
+		logrus.Errorf("stream entry %v is invalid with err %v, skip it", id, err)
+		return nil
+	}
+
+	// the checkpoint of an earlier run may cover the entry, the insert is then skipped and its ids must be kept. An
+	// insert the route of the target excludes is skipped too, its collection may not exist on the target
+	if message.Action == Insert && b.syncer.routed(message, 0) && !b.syncer.newCheckpoint(0).applied(message) {
+		ids, _ := message.Entities()
+
+		mapped := b.syncer.mapNames(message, 0)
+
+		err = b.syncer.retryPolicy.do(ctx, func() error {
+			return b.target.DeleteBatch(mapped.CollectionName, mapped.PartitionTag, ids)
+		})
+		if err != nil {
+			return fmt.Errorf("delete the ids of stream entry %v before replaying it is failed with err %w", id, err)
+		}
+	}
+
+	b.syncer.received([]string{payload}, b.syncer.targets())
+
+	// an entry buried in the dead letter sink is not failed, the sink keeps it
+	failed, _ := b.syncer.handleAll(ctx, payload, b.syncer.targets())
+	if len(failed) > 0 {
+		return fmt.Errorf("replay stream entry %v is failed", id)
+	}
+
+	return nil
+}
+
+// parseNList reads nlist from the index parameters of the source, Milvus returns it either as a number or a string
+func parseNList(extraParams string) (int64, error) {
+	if extraParams == "" {
+		return 0, nil
+	}
+
+	var params map[string]interface{}
+
+	err := json.Unmarshal([]byte(extraParams), &params)
+	if err != nil {
+		return 0, fmt.Errorf("the index params %v are invalid: %v", extraParams, err)
+	}
+
+	switch nList := params["nlist"].(type) {
+	case float64:
+		return int64(nList), nil
+	case string:
+		return strconv.ParseInt(nList, 10, 64)
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,169 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBootstrapCopiesTheSnapshotAndReplaysTheEntriesAppendedMeanwhile(t *testing.T) {
+	_, client := newTestRedis(t)
+	source, target := &fakeSnapshot{}, &fakeMilvus{}
+	source.add("c", "", 1, 2)
+	source.add("c", "p", 3)
+
+	xadd(t, client, "cdc", insertPayload(t, "c", 1, 0))
+	source.onList = func() {
+		source.onList = nil
+		xadd(t, client, "cdc", insertPayload(t, "c", 4, 0))
+	}
+
+	broker, err := NewBootstrap(source, target, client, "cdc", WithConsumerGroup("bootstrap", "replica")).Run(context.Background())
+	if err != nil || broker == nil {
+		t.Fatalf("bootstrap is failed with err %v", err)
+	}
+
+	ops := make([]string, 0)
+	for _, call := range target.Calls() {
+		ops = append(ops, call.Op)
+	}
+
+	if !slices.Equal(ops, []string{CreateCollection, CreatePartition, Insert, Insert, Delete, Insert}) {
+		t.Fatalf("the target received %v", ops)
+	}
+
+	if ids := target.Ids(); !slices.Equal(ids, []int64{1, 2, 3, -4, 4}) {
+		t.Fatalf("the target received the ids %v", ids)
+	}
+
+	err = client.XGroupCreate(context.Background(), "cdc", "bootstrap", "0").Err()
+	if err == nil || !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		t.Fatalf("the consumer group is not created, creating it again is answered with err %v", err)
+	}
+}
+
+func TestBootstrapStopsAtAFailedReplay(t *testing.T) {
+	_, client := newTestRedis(t)
+	source, target := &fakeSnapshot{}, &fakeMilvus{}
+	source.add("c", "", 1)
+	source.onList = func() {
+		source.onList = nil
+		xadd(t, client, "cdc", insertPayload(t, "c", 4, 0))
+		xadd(t, client, "cdc", insertPayload(t, "c", 5, 0))
+	}
+
+	target.setFail(func(call fakeCall) error {
+		if call.Op == Insert && slices.Contains(call.Ids, 4) {
+			return &MilvusError{Op: "fake", Class: ErrorClassPermanent, Err: errors.New("rejected")}
+		}
+
+		return nil
+	})
+
+	_, err := NewBootstrap(source, target, client, "cdc", WithConsumerGroup("bootstrap", "replica")).Run(context.Background())
+	if err == nil {
+		t.Fatalf("the bootstrap ends after a failed replay")
+	}
+
+	if slices.Contains(target.Ids(), 5) {
+		t.Fatalf("the entry after the failed one is replayed: %v", target.Ids())
+	}
+
+	err = client.XGroupCreate(context.Background(), "cdc", "bootstrap", "0").Err()
+	if err != nil {
+		t.Fatalf("the consumer group is created after a failed replay, creating it again is failed with err %v", err)
+	}
+}
+
+func TestBootstrapRerunReplacesTheEntitiesOfExistingCollections(t *testing.T) {
+	_, client := newTestRedis(t)
+	source, target := &fakeSnapshot{}, &fakeMilvus{}
+	source.add("c", "p", 1, 2)
+
+	target.setFail(func(call fakeCall) error {
+		if call.Op == CreateCollection || call.Op == CreatePartition {
+			return &MilvusError{Op: call.Op, Code: 1, Message: "collection c already exists", Class: ErrorClassPermanent}
+		}
+
+		return nil
+	})
+
+	_, err := NewBootstrap(source, target, client, "cdc", WithConsumerGroup("bootstrap", "replica")).Run(context.Background())
+	if err != nil {
+		t.Fatalf("bootstrap is failed with err %v", err)
+	}
+
+	if ids := target.Ids(); !slices.Equal(ids, []int64{-1, -2, 1, 2}) {
+		t.Fatalf("the target received the ids %v, wanted the entities deleted before they are inserted", ids)
+	}
+}
+
+func TestBootstrapRerunKeepsTheInsertsItsCheckpointCovers(t *testing.T) {
+	_, client := newTestRedis(t)
+	source, target := &fakeSnapshot{}, &fakeMilvus{}
+	source.add("c", "", 1, 4)
+
+	// an earlier run applied the insert of 4 before it failed, its checkpoint is kept under the consumer group
+	store := NewRedisCheckpointStore(client, DefaultCheckpointKey+":bootstrap")
+	err := store.Set(context.Background(), "0", "c", 1)
+	if err != nil {
+		t.Fatalf("set checkpoint is failed with err %v", err)
+	}
+
+	source.onList = func() {
+		source.onList = nil
+		xadd(t, client, "cdc", insertPayload(t, "c", 4, 1))
+		xadd(t, client, "cdc", insertPayload(t, "c", 5, 2))
+	}
+
+	target.setFail(func(call fakeCall) error {
+		if call.Op == CreateCollection {
+			return &MilvusError{Op: call.Op, Code: 1, Message: "collection c already exists", Class: ErrorClassPermanent}
+		}
+
+		return nil
+	})
+
+	_, err = NewBootstrap(source, target, client, "cdc", WithConsumerGroup("bootstrap", "replica")).Run(context.Background())
+	if err != nil {
+		t.Fatalf("bootstrap is failed with err %v", err)
+	}
+
+	// the snapshot replaces 1 and 4, the insert of 4 is covered by the checkpoint and the one of 5 is replayed
+	if ids := target.Ids(); !slices.Equal(ids, []int64{-1, -4, 1, 4, -5, 5}) {
+		t.Fatalf("the target received the ids %v, wanted 4 kept", ids)
+	}
+}
+
+func TestBootstrapReplaysOnlyTheRoutedInserts(t *testing.T) {
+	_, client := newTestRedis(t)
+	source, target := &fakeSnapshot{}, &fakeMilvus{}
+	source.add("c", "", 1)
+	source.add("excluded", "", 2)
+	source.onList = func() {
+		source.onList = nil
+		xadd(t, client, "cdc", insertPayload(t, "excluded", 3, 0))
+		xadd(t, client, "cdc", insertPayload(t, "c", 4, 0))
+	}
+
+	// the collections the route excludes are not copied, so they do not exist on the target
+	target.setFail(func(call fakeCall) error {
+		if call.CollectionName == "excluded" {
+			return &MilvusError{Op: call.Op, Code: 4, Message: "collection excluded does not exist", Class: ErrorClassPermanent}
+		}
+
+		return nil
+	})
+
+	_, err := NewBootstrap(source, target, client, "cdc", WithConsumerGroup("bootstrap", "replica"),
+		WithRoute(0, Route{Include: []RouteRule{{Collections: []string{"c"}}}})).Run(context.Background())
+	if err != nil {
+		t.Fatalf("bootstrap is failed with err %v", err)
+	}
+
+	if ids := target.Ids(); !slices.Equal(ids, []int64{1, -4, 4}) {
+		t.Fatalf("the target received the ids %v", ids)
+	}
+}
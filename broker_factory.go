@@ -2,23 +2,125 @@ package milvus_cdc
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"sync"
 )
 
+type BrokerFactoryOption func(*BrokerFactory)
+
+type UnknownBrokerError struct {
+	Name       string
+	Registered []string
+}
+
+func (e *UnknownBrokerError) Error() string {
+	return fmt.Sprintf("the broker %v is invalid, registered brokers are %v", e.Name, e.Registered)
+}
+
 type BrokerFactory struct {
-	redisBroker *RedisBroker
+	mu      sync.RWMutex
+	brokers map[string]IBrokerFactory
 }
 
+// NewBrokerFactory registers the redis broker under Redis, use NewBrokerFactoryWithOptions to register other brokers
 func NewBrokerFactory(redisBroker *RedisBroker) *BrokerFactory {
-	return &BrokerFactory{
-		redisBroker: redisBroker,
+	return NewBrokerFactoryWithOptions(WithRedisBroker(redisBroker))
+}
+
+func NewBrokerFactoryWithOptions(opts ...BrokerFactoryOption) *BrokerFactory {
+	bf := &BrokerFactory{
+		brokers: make(map[string]IBrokerFactory),
+	}
+
+	for _, opt := range opts {
+		opt(bf)
+	}
+
+	return bf
+}
+
+func WithBroker(name string, broker IBrokerFactory) BrokerFactoryOption {
+	return func(bf *BrokerFactory) {
+		if !isNil(broker) {
+			bf.brokers[name] = broker
+		}
 	}
 }
 
+func WithRedisBroker(redisBroker *RedisBroker) BrokerFactoryOption {
+	return WithBroker(Redis, redisBroker)
+}
+
+func WithKafkaBroker(kafkaBroker *KafkaBroker) BrokerFactoryOption {
+	return WithBroker(Kafka, kafkaBroker)
+}
+
+func WithNatsBroker(natsBroker *NatsBroker) BrokerFactoryOption {
+	return WithBroker(Nats, natsBroker)
+}
+
+func WithRabbitMQBroker(mqBroker *RabbitMQBroker) BrokerFactoryOption {
+	return WithBroker(RabbitMQ, mqBroker)
+}
+
+func (bf *BrokerFactory) Register(name string, broker IBrokerFactory) error {
+	if name == "" {
+		return fmt.Errorf("the broker name is empty")
+	}
+
+	if isNil(broker) {
+		return fmt.Errorf("the broker %v is nil", name)
+	}
+
+	bf.mu.Lock()
+	defer bf.mu.Unlock()
+
+	if _, ok := bf.brokers[name]; ok {
+		return fmt.Errorf("the broker %v is already registered", name)
+	}
+
+	bf.brokers[name] = broker
+
+	return nil
+}
+
+// isNil also catches a nil pointer wrapped in the interface, such as a nil *RedisBroker passed to WithRedisBroker
+func isNil(broker IBrokerFactory) bool {
+	if broker == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(broker)
+
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
+
+func (bf *BrokerFactory) Names() []string {
+	bf.mu.RLock()
+	defer bf.mu.RUnlock()
+
+	names := make([]string, 0, len(bf.brokers))
+	for name := range bf.brokers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
 func (bf *BrokerFactory) GetBrokerFactory(name string) (IBrokerFactory, error) {
-	switch name {
-	case Redis:
-		return bf.redisBroker, nil
+	bf.mu.RLock()
+	broker, ok := bf.brokers[name]
+	bf.mu.RUnlock()
+
+	if !ok {
+		return nil, &UnknownBrokerError{
+			Name:       name,
+			Registered: bf.Names(),
+		}
 	}
 
-	return nil, fmt.Errorf("the broker is invaild")
+	return broker, nil
 }
@@ -0,0 +1,56 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+type stubBroker struct{}
+
+func (stubBroker) Start(_, _ string) error {
+	return nil
+}
+
+func (stubBroker) Stop(_ context.Context) error {
+	return nil
+}
+
+func TestBrokerFactoryRegister(t *testing.T) {
+	var nilRedis *RedisBroker
+
+	bf := NewBrokerFactoryWithOptions(WithRedisBroker(nilRedis), WithBroker("custom", stubBroker{}))
+	if names := bf.Names(); !slices.Equal(names, []string{"custom"}) {
+		t.Fatalf("the registered brokers are %v, the nil redis broker must be skipped", names)
+	}
+
+	var nilKafka *KafkaBroker
+	if err := bf.Register(Kafka, nilKafka); err == nil {
+		t.Fatalf("a nil kafka broker is registered")
+	}
+
+	if err := bf.Register("custom", stubBroker{}); err == nil {
+		t.Fatalf("a broker is registered twice")
+	}
+
+	if err := bf.Register("other", stubBroker{}); err != nil {
+		t.Fatalf("register broker is failed with err %v", err)
+	}
+
+	_, err := bf.GetBrokerFactory(Redis)
+
+	var unknown *UnknownBrokerError
+	if !errors.As(err, &unknown) || !slices.Equal(unknown.Registered, []string{"custom", "other"}) {
+		t.Fatalf("get an unknown broker returns %v", err)
+	}
+}
+
+func TestNewBrokerFactoryRegistersRedis(t *testing.T) {
+	redisBroker := &RedisBroker{}
+
+	broker, err := NewBrokerFactory(redisBroker).GetBrokerFactory(Redis)
+	if err != nil || broker != redisBroker {
+		t.Fatalf("get the redis broker returns %v and err %v", broker, err)
+	}
+}
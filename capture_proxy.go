@@ -0,0 +1,434 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus/grpc/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const milvusServicePrefix = "/milvus.grpc.MilvusService/"
+
+// CaptureProxy serves the Milvus 1.x gRPC API in front of a primary. Every call is forwarded as is, and the writes and
+// DDL the primary accepted are published as MessageCDC, so the replicas follow what the primary applied instead of
+// what the application meant to write
+type CaptureProxy struct {
+	conn       *grpc.ClientConn
+	server     *grpc.Server
+	publisher  *Publisher
+	address    string
+	rpcMetrics *Metrics
+	// collections serialise the captured calls of a collection, so they are published in the order the primary
+	// applied them
+	collections collectionLocks
+	// ctx is cancelled by Stop and ends the publishes being retried
+	ctx        context.Context
+	cancel     context.CancelFunc
+	retryDelay time.Duration
+}
+
+// NewCaptureProxy connects to the primary, the options set the TLS of the primary connection and the metrics of the
+// forwarded calls. The publisher is owned by the caller and stays open when the proxy stops
+func NewCaptureProxy(host, port string, publisher *Publisher, opts ...MilvusOption) (*CaptureProxy, error) {
+	o := newMilvusOptions(opts...)
+	address := net.JoinHostPort(host, port)
+
+	// the transport of the options takes precedence over the plaintext default
+	dialOpts := append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, o.dialOptions()...)
+	dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(
+		grpc.ForceCodec(rawCodec{}),
+		grpc.MaxCallRecvMsgSize(math.MaxInt32),
+		grpc.MaxCallSendMsgSize(math.MaxInt32),
+	))
+
+	conn, err := grpc.Dial(address, dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("dial primary %v is failed with err %v", address, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cp := &CaptureProxy{
+		conn:       conn,
+		publisher:  publisher,
+		address:    address,
+		rpcMetrics: o.metrics,
+		ctx:        ctx,
+		cancel:     cancel,
+		retryDelay: DefaultRetryDelay,
+	}
+
+	cp.server = grpc.NewServer(
+		grpc.UnknownServiceHandler(cp.forward),
+		grpc.ForceServerCodec(rawCodec{}),
+		grpc.MaxRecvMsgSize(math.MaxInt32),
+		grpc.MaxSendMsgSize(math.MaxInt32),
+	)
+
+	return cp, nil
+}
+
+func (cp *CaptureProxy) ListenAndServe(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return cp.Serve(lis)
+}
+
+// Serve accepts the Milvus clients on lis until Stop is called
+func (cp *CaptureProxy) Serve(lis net.Listener) error {
+	return cp.server.Serve(lis)
+}
+
+// Stop waits until ctx is done for the calls in flight, so their events are published, and closes the primary
+// connection. The events still failing to publish when ctx is done are logged and lost
+func (cp *CaptureProxy) Stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		cp.server.GracefulStop()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		cp.cancel()
+		cp.server.Stop()
+		err = fmt.Errorf("the calls in flight are cancelled: %w", ctx.Err())
+	}
+
+	cp.cancel()
+
+	return errors.Join(err, cp.conn.Close())
+}
+
+// forward sends the call to the primary without decoding it, every Milvus 1.x RPC is unary
+func (cp *CaptureProxy) forward(_ interface{}, stream grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Errorf(codes.Internal, "the method of the stream is unknown")
+	}
+
+	var req rawFrame
+	err := stream.RecvMsg(&req)
+	if err != nil {
+		return err
+	}
+
+	var resp rawFrame
+	call, ok := captures[strings.TrimPrefix(method, milvusServicePrefix)]
+	if ok {
+		err = cp.apply(stream.Context(), method, call, &req, &resp)
+	} else {
+		err = cp.invoke(stream.Context(), method, &req, &resp)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return stream.SendMsg(&resp)
+}
+
+// apply forwards a captured call and publishes its message while holding the lock of its collection, so the calls of
+// a collection are sequenced and published in the order the primary applied them
+func (cp *CaptureProxy) apply(ctx context.Context, method string, call captureCall, req, resp *rawFrame) error {
+	param := call.request()
+	err := proto.Unmarshal(req.payload, param)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "the %v request cannot be decoded: %v",
+			strings.TrimPrefix(method, milvusServicePrefix), err)
+	}
+
+	unlock := cp.collections.lock(param.GetCollectionName())
+	defer unlock()
+
+	err = cp.invoke(ctx, method, req, resp)
+	if err != nil {
+		return err
+	}
+
+	cp.capture(ctx, method, call, param, resp.payload)
+
+	return nil
+}
+
+func (cp *CaptureProxy) invoke(ctx context.Context, method string, req, resp *rawFrame) error {
+	defer cp.rpcMetrics.observeRPC(cp.address, strings.TrimPrefix(method, milvusServicePrefix), time.Now())
+
+	return cp.conn.Invoke(ctx, method, req, resp)
+}
+
+// capture publishes the message replicating a call the primary accepted, calls the primary rejected publish nothing.
+// The call is committed on the primary whatever happens here, so the client always gets the answer of the primary and
+// the publish is retried, even if the client went away meanwhile, until it succeeds or the proxy stops
+func (cp *CaptureProxy) capture(ctx context.Context, method string, call captureCall, param collectionParam, resp []byte) {
+	message, err := call.message(param, resp)
+	if err != nil {
+		logrus.Errorf("capture %v is failed with err %v, the call is applied on the primary but not replicated", method, err)
+		return
+	}
+
+	if message == nil {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+	for {
+		err = cp.publish(ctx, message)
+		if err == nil {
+			return
+		}
+
+		if cp.ctx.Err() != nil {
+			logrus.Errorf("the proxy is stopped, the %v message %v of collection %v is applied on the primary but not "+
+				"published: %v", message.Action, message.EventId, message.CollectionName, err)
+			return
+		}
+
+		logrus.Warnf("%v, retrying in %v", err, cp.retryDelay)
+		sleep(cp.ctx, cp.retryDelay)
+	}
+}
+
+// publish gives the message the next sequence of its collection and sends it. The message keeps its event id and
+// sequence across the attempts, so a message sent twice is applied once
+func (cp *CaptureProxy) publish(ctx context.Context, message *MessageCDC) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	if cp.publisher.sequencer != nil && message.Sequence == 0 {
+		sequence, err := cp.publisher.sequencer.Next(ctx, message.CollectionName)
+		if err != nil {
+			return fmt.Errorf("sequence %v message of collection %v is failed with err %v", message.Action,
+				message.CollectionName, err)
+		}
+
+		message.Sequence = sequence
+	}
+
+	_, err := cp.publisher.Publish(ctx, message)
+
+	return err
+}
+
+// collectionParam is the request of a captured call, every one names its collection
+type collectionParam interface {
+	proto.Message
+	GetCollectionName() string
+}
+
+type captureCall struct {
+	// request returns an empty request of the call
+	request func() collectionParam
+	// message returns the message replicating the call, it is nil when the primary rejected the call
+	message func(param collectionParam, resp []byte) (*MessageCDC, error)
+}
+
+// captures maps the write and DDL RPCs of the Milvus 1.x API to the message replicating them
+var captures = map[string]captureCall{
+	"CreateCollection":  {request: func() collectionParam { return &gen.CollectionSchema{} }, message: captureCreateCollection},
+	"DropCollection":    {request: func() collectionParam { return &gen.CollectionName{} }, message: captureCollection(DropCollection)},
+	"CreatePartition":   {request: func() collectionParam { return &gen.PartitionParam{} }, message: capturePartition(CreatePartition)},
+	"DropPartition":     {request: func() collectionParam { return &gen.PartitionParam{} }, message: capturePartition(DropPartition)},
+	"CreateIndex":       {request: func() collectionParam { return &gen.IndexParam{} }, message: captureCreateIndex},
+	"DropIndex":         {request: func() collectionParam { return &gen.CollectionName{} }, message: captureCollection(DropIndex)},
+	"Insert":            {request: func() collectionParam { return &gen.InsertParam{} }, message: captureInsert},
+	"DeleteByID":        {request: func() collectionParam { return &gen.DeleteByIDParam{} }, message: captureDelete},
+	"PreloadCollection": {request: func() collectionParam { return &gen.PreloadCollectionParam{} }, message: captureCollection(LoadCollection)},
+	"ReleaseCollection": {request: func() collectionParam { return &gen.PreloadCollectionParam{} }, message: captureCollection(ReleaseCollection)},
+}
+
+func captureCreateCollection(param collectionParam, resp []byte) (*MessageCDC, error) {
+	ok, err := accepted(resp)
+	if !ok {
+		return nil, err
+	}
+
+	schema := param.(*gen.CollectionSchema)
+
+	return &MessageCDC{
+		Action:         CreateCollection,
+		CollectionName: schema.CollectionName,
+		Dimension:      schema.Dimension,
+		IndexFileSize:  schema.IndexFileSize,
+		MetricType:     milvus.MetricType(schema.MetricType),
+	}, nil
+}
+
+// captureCollection replicates the calls on a whole collection, the partitions of a load or release are ignored
+func captureCollection(action string) func(param collectionParam, resp []byte) (*MessageCDC, error) {
+	return func(param collectionParam, resp []byte) (*MessageCDC, error) {
+		ok, err := accepted(resp)
+		if !ok {
+			return nil, err
+		}
+
+		return &MessageCDC{
+			Action:         action,
+			CollectionName: param.GetCollectionName(),
+		}, nil
+	}
+}
+
+func capturePartition(action string) func(param collectionParam, resp []byte) (*MessageCDC, error) {
+	return func(param collectionParam, resp []byte) (*MessageCDC, error) {
+		ok, err := accepted(resp)
+		if !ok {
+			return nil, err
+		}
+
+		partition := param.(*gen.PartitionParam)
+
+		return &MessageCDC{
+			Action:         action,
+			CollectionName: partition.CollectionName,
+			PartitionTag:   partition.Tag,
+		}, nil
+	}
+}
+
+func captureCreateIndex(param collectionParam, resp []byte) (*MessageCDC, error) {
+	ok, err := accepted(resp)
+	if !ok {
+		return nil, err
+	}
+
+	index := param.(*gen.IndexParam)
+	message := &MessageCDC{
+		Action:         CreateIndex,
+		CollectionName: index.CollectionName,
+		IndexType:      milvus.IndexType(index.IndexType),
+	}
+
+	// the sdk sends the index parameters as a JSON object under the params key
+	for _, extra := range index.ExtraParams {
+		if extra.Key != "params" {
+			continue
+		}
+
+		var params struct {
+			NList int64 `json:"nlist"`
+		}
+
+		err = json.Unmarshal([]byte(extra.Value), &params)
+		if err != nil {
+			return nil, fmt.Errorf("the index params %v are invalid: %v", extra.Value, err)
+		}
+
+		message.NList = params.NList
+	}
+
+	return message, nil
+}
+
+// captureInsert replicates the ids the primary assigned, so the entities keep the same ids on every replica
+func captureInsert(param collectionParam, resp []byte) (*MessageCDC, error) {
+	var ids gen.VectorIds
+	err := proto.Unmarshal(resp, &ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if ids.GetStatus().GetErrorCode() != gen.ErrorCode_SUCCESS {
+		return nil, nil
+	}
+
+	insert := param.(*gen.InsertParam)
+	vectors := make([]string, 0, len(insert.RowRecordArray))
+	for _, record := range insert.RowRecordArray {
+		if len(record.FloatData) == 0 {
+			return nil, fmt.Errorf("binary vectors are not supported")
+		}
+
+		vectors = append(vectors, EncodeVector(record.FloatData))
+	}
+
+	entityIds := ids.VectorIdArray
+	if len(entityIds) == 0 {
+		entityIds = insert.RowIdArray
+	}
+
+	return &MessageCDC{
+		Action:         Insert,
+		CollectionName: insert.CollectionName,
+		PartitionTag:   insert.PartitionTag,
+		Ids:            entityIds,
+		Vectors:        vectors,
+	}, nil
+}
+
+func captureDelete(param collectionParam, resp []byte) (*MessageCDC, error) {
+	ok, err := accepted(resp)
+	if !ok {
+		return nil, err
+	}
+
+	deleteParam := param.(*gen.DeleteByIDParam)
+
+	return &MessageCDC{
+		Action:         Delete,
+		CollectionName: deleteParam.CollectionName,
+		PartitionTag:   deleteParam.PartitionTag,
+		Ids:            deleteParam.IdArray,
+	}, nil
+}
+
+// accepted decodes the Status answering a call, it reports false when the primary rejected the call or the answer
+// cannot be decoded
+func accepted(resp []byte) (bool, error) {
+	var callStatus gen.Status
+	err := proto.Unmarshal(resp, &callStatus)
+	if err != nil {
+		return false, err
+	}
+
+	return callStatus.ErrorCode == gen.ErrorCode_SUCCESS, nil
+}
+
+// rawFrame holds an encoded message, the proxy forwards the bytes and only decodes the calls it captures
+type rawFrame struct {
+	payload []byte
+}
+
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	frame, ok := v.(*rawFrame)
+	if !ok {
+		return nil, fmt.Errorf("the message %T is not a raw frame", v)
+	}
+
+	return frame.payload, nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	frame, ok := v.(*rawFrame)
+	if !ok {
+		return fmt.Errorf("the message %T is not a raw frame", v)
+	}
+
+	frame.payload = append(frame.payload[:0], data...)
+
+	return nil
+}
+
+// Name keeps the content subtype of the Milvus clients
+func (rawCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,305 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus/grpc/gen"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// milvusV1Stub is a Milvus 1.x gRPC stand-in of the primary, it records the ids of the inserts in the order it
+// applied them
+type milvusV1Stub struct {
+	gen.UnimplementedMilvusServiceServer
+
+	mu      sync.Mutex
+	applied []int64
+	// reject answers every call with an error status when it is set
+	reject bool
+}
+
+func (s *milvusV1Stub) status() *gen.Status {
+	if s.reject {
+		return &gen.Status{ErrorCode: gen.ErrorCode_COLLECTION_NOT_EXISTS, Reason: "collection not exists"}
+	}
+
+	return &gen.Status{ErrorCode: gen.ErrorCode_SUCCESS}
+}
+
+func (s *milvusV1Stub) Insert(_ context.Context, req *gen.InsertParam) (*gen.VectorIds, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.reject {
+		s.applied = append(s.applied, req.RowIdArray...)
+	}
+
+	return &gen.VectorIds{Status: s.status(), VectorIdArray: req.RowIdArray}, nil
+}
+
+func (s *milvusV1Stub) DropCollection(_ context.Context, _ *gen.CollectionName) (*gen.Status, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.status(), nil
+}
+
+func (s *milvusV1Stub) setReject(reject bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reject = reject
+}
+
+func (s *milvusV1Stub) Applied() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]int64(nil), s.applied...)
+}
+
+// recordingTransport keeps the messages it sends, the first fails sends and every send while down are failed
+type recordingTransport struct {
+	mu       sync.Mutex
+	fails    int
+	down     bool
+	attempts []MessageCDC
+	sent     []MessageCDC
+}
+
+func (r *recordingTransport) Send(_ context.Context, message *MessageCDC, _ []byte) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attempts = append(r.attempts, *message)
+	if r.down || r.fails > 0 {
+		r.fails--
+		return "", errors.New("broker is down")
+	}
+
+	r.sent = append(r.sent, *message)
+
+	return message.EventId, nil
+}
+
+func (r *recordingTransport) Close() error {
+	return nil
+}
+
+func (r *recordingTransport) Attempts() []MessageCDC {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]MessageCDC(nil), r.attempts...)
+}
+
+func (r *recordingTransport) Sent() []MessageCDC {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]MessageCDC(nil), r.sent...)
+}
+
+// countingSequencer hands out 1, 2, 3... per collection
+type countingSequencer struct {
+	mu        sync.Mutex
+	sequences map[string]int64
+}
+
+func (c *countingSequencer) Next(_ context.Context, collectionName string) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sequences == nil {
+		c.sequences = make(map[string]int64)
+	}
+
+	c.sequences[collectionName]++
+
+	return c.sequences[collectionName], nil
+}
+
+// newCaptureProxy serves the stub as the primary and the proxy in front of it, and returns a client of the proxy
+func newCaptureProxy(t *testing.T, stub *milvusV1Stub, transport IPublisherTransport) (*CaptureProxy, gen.MilvusServiceClient) {
+	t.Helper()
+
+	primary, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen is failed with err %v", err)
+	}
+
+	server := grpc.NewServer()
+	gen.RegisterMilvusServiceServer(server, stub)
+	go func() {
+		_ = server.Serve(primary)
+	}()
+	t.Cleanup(server.Stop)
+
+	host, port, _ := net.SplitHostPort(primary.Addr().String())
+	proxy, err := NewCaptureProxy(host, port, NewPublisher(transport, WithSequencer(&countingSequencer{})))
+	if err != nil {
+		t.Fatalf("new capture proxy is failed with err %v", err)
+	}
+	proxy.retryDelay = 10 * time.Millisecond
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen is failed with err %v", err)
+	}
+
+	go func() {
+		_ = proxy.Serve(lis)
+	}()
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_ = proxy.Stop(ctx)
+	})
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial proxy is failed with err %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return proxy, gen.NewMilvusServiceClient(conn)
+}
+
+func insertRecord(id int64) *gen.InsertParam {
+	return &gen.InsertParam{
+		CollectionName: "c",
+		RowRecordArray: []*gen.RowRecord{{FloatData: []float32{float32(id)}}},
+		RowIdArray:     []int64{id},
+	}
+}
+
+func TestCaptureProxyPublishesTheCallsThePrimaryAccepted(t *testing.T) {
+	stub := &milvusV1Stub{}
+	transport := &recordingTransport{}
+	_, client := newCaptureProxy(t, stub, transport)
+
+	ids, err := client.Insert(context.Background(), insertRecord(7))
+	if err != nil || ids.GetStatus().GetErrorCode() != gen.ErrorCode_SUCCESS {
+		t.Fatalf("insert is failed with err %v and status %v", err, ids.GetStatus())
+	}
+
+	sent := transport.Sent()
+	if len(sent) != 1 || sent[0].Action != Insert || sent[0].Ids[0] != 7 || sent[0].Sequence != 1 {
+		t.Fatalf("the sent messages are %+v", sent)
+	}
+
+	stub.setReject(true)
+	callStatus, err := client.DropCollection(context.Background(), &gen.CollectionName{CollectionName: "c"})
+	if err != nil || callStatus.GetErrorCode() != gen.ErrorCode_COLLECTION_NOT_EXISTS {
+		t.Fatalf("drop collection is answered with err %v and status %v", err, callStatus)
+	}
+
+	if sent = transport.Sent(); len(sent) != 1 {
+		t.Fatalf("the rejected call is published: %+v", sent)
+	}
+}
+
+func TestCaptureProxyPublishesInTheOrderThePrimaryApplied(t *testing.T) {
+	stub := &milvusV1Stub{}
+	transport := &recordingTransport{}
+	_, client := newCaptureProxy(t, stub, transport)
+
+	var wg sync.WaitGroup
+	for i := int64(1); i <= 20; i++ {
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+
+			_, errInsert := client.Insert(context.Background(), insertRecord(id))
+			if errInsert != nil {
+				t.Errorf("insert is failed with err %v", errInsert)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	sent := transport.Sent()
+	sort.Slice(sent, func(i, j int) bool {
+		return sent[i].Sequence < sent[j].Sequence
+	})
+
+	applied := stub.Applied()
+	if len(sent) != len(applied) {
+		t.Fatalf("%d messages are sent for %d inserts", len(sent), len(applied))
+	}
+
+	for i, message := range sent {
+		if message.Sequence != int64(i+1) || message.Ids[0] != applied[i] {
+			t.Fatalf("the message %d has the sequence %d and the id %d, the primary applied %v", i, message.Sequence,
+				message.Ids[0], applied)
+		}
+	}
+}
+
+func TestCaptureProxyRetriesThePublishOfACommittedCall(t *testing.T) {
+	stub := &milvusV1Stub{}
+	transport := &recordingTransport{fails: 2}
+	_, client := newCaptureProxy(t, stub, transport)
+
+	ids, err := client.Insert(context.Background(), insertRecord(7))
+	if err != nil || ids.GetStatus().GetErrorCode() != gen.ErrorCode_SUCCESS {
+		t.Fatalf("insert is failed with err %v and status %v", err, ids.GetStatus())
+	}
+
+	attempts := transport.Attempts()
+	if len(attempts) != 3 || len(transport.Sent()) != 1 {
+		t.Fatalf("the message is published after %d attempts and sent %d times", len(attempts), len(transport.Sent()))
+	}
+
+	for _, attempt := range attempts {
+		if attempt.EventId != attempts[0].EventId || attempt.Sequence != 1 {
+			t.Fatalf("the attempts are %+v, every attempt must keep the event id and sequence", attempts)
+		}
+	}
+}
+
+func TestCaptureProxyStopEndsTheRetries(t *testing.T) {
+	stub := &milvusV1Stub{}
+	transport := &recordingTransport{down: true}
+	proxy, client := newCaptureProxy(t, stub, transport)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		_, _ = client.Insert(context.Background(), insertRecord(7))
+	}()
+
+	eventually(t, func() bool {
+		return len(transport.Attempts()) > 1
+	}, "the publish is not retried")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := proxy.Stop(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("stop is failed with err %v, wanted the calls in flight to be cancelled", err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the call is still retried after the proxy stopped")
+	}
+
+	if len(transport.Sent()) != 0 {
+		t.Fatalf("the messages %+v are sent", transport.Sent())
+	}
+}
@@ -0,0 +1,634 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+)
+
+// Drift is a difference between the source and a target, Source and Target describe what each side holds when the
+// drift is not about ids
+type Drift struct {
+	Kind       string  `json:"kind"`
+	Collection string  `json:"collection"`
+	Partition  string  `json:"partition,omitempty"`
+	Ids        []int64 `json:"ids,omitempty"`
+	Source     string  `json:"source,omitempty"`
+	Target     string  `json:"target,omitempty"`
+}
+
+type TargetDrift struct {
+	Target     int    `json:"target"`
+	Consistent bool   `json:"consistent"`
+	Error      string `json:"error,omitempty"`
+	// Collections and Entities count what was compared, the entities are the sampled ones
+	Collections int     `json:"collections"`
+	Entities    int64   `json:"entities"`
+	Drifts      []Drift `json:"drifts"`
+	Corrections int     `json:"corrections"`
+}
+
+type DriftReport struct {
+	Consistent bool          `json:"consistent"`
+	SampleRate float64       `json:"sample_rate"`
+	StartedAt  time.Time     `json:"started_at"`
+	FinishedAt time.Time     `json:"finished_at"`
+	Targets    []TargetDrift `json:"targets"`
+}
+
+type CheckerOption func(*Checker)
+
+// WithSampleRate compares only the given share of the entities, between 0 and 1. The ids are sampled by their hash so
+// the source and the targets sample the same ones
+func WithSampleRate(rate float64) CheckerOption {
+	return func(c *Checker) {
+		if rate > 0 && rate <= 1 {
+			c.sampleRate = rate
+		}
+	}
+}
+
+// WithCheckBatchSize sets how many entities are read with one call
+func WithCheckBatchSize(size int) CheckerOption {
+	return func(c *Checker) {
+		if size > 0 {
+			c.batchSize = size
+		}
+	}
+}
+
+// WithCheckSettle sets how long the entities found drifting wait before they are read again, replication in flight
+// catches up meanwhile and only the ids still drifting are reported
+func WithCheckSettle(settle time.Duration) CheckerOption {
+	return func(c *Checker) {
+		if settle >= 0 {
+			c.settle = settle
+		}
+	}
+}
+
+// WithTargetRoute skips the collections and partitions the route of the target does not select, they are neither
+// compared nor reported as missing
+func WithTargetRoute(target int, route Route) CheckerOption {
+	return func(c *Checker) {
+		if c.routes == nil {
+			c.routes = make(map[int]Route)
+		}
+
+		c.routes[target] = route
+	}
+}
+
+// WithTargetMapping compares the collections and partitions of the source with the ones the name mapping gives them
+// on the target, the report keeps the names of the source
+func WithTargetMapping(target int, mapping NameMapping) CheckerOption {
+	return func(c *Checker) {
+		if c.mappings == nil {
+			c.mappings = make(map[int]NameMapping)
+		}
+
+		c.mappings[target] = mapping
+	}
+}
+
+// WithCorrections publishes the messages fixing the entity and index drifts, missing or extra collections and
+// partitions are only reported. The messages reach every target of the channel, so they are idempotent: the
+// entities are deleted before they are inserted again with the vectors of the source
+func WithCorrections(publisher *Publisher) CheckerOption {
+	return func(c *Checker) {
+		c.publisher = publisher
+	}
+}
+
+// Checker compares the collections, partitions, indexes, entity counts, ids and vectors of targets with the source
+type Checker struct {
+	source     IMilvusSnapshotClientInterface
+	targets    []IMilvusSnapshotClientInterface
+	sampleRate float64
+	batchSize  int
+	settle     time.Duration
+	routes     map[int]Route
+	mappings   map[int]NameMapping
+	publisher  *Publisher
+}
+
+// checkedTarget is a target with its route and name mapping, the zero route and mapping select and keep everything
+type checkedTarget struct {
+	cli     IMilvusSnapshotClientInterface
+	route   Route
+	mapping NameMapping
+}
+
+func NewChecker(source IMilvusSnapshotClientInterface, targets []IMilvusSnapshotClientInterface, opts ...CheckerOption) *Checker {
+	c := &Checker{
+		source:     source,
+		targets:    targets,
+		sampleRate: DefaultSampleRate,
+		batchSize:  DefaultCheckBatchSize,
+		settle:     DefaultCheckSettle,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Check compares the targets with the source one after the other. A target that cannot be read is reported with its
+// error and the others are still checked, the error is only returned when the source cannot be listed or ctx is done
+func (c *Checker) Check(ctx context.Context) (*DriftReport, error) {
+	report := &DriftReport{
+		Consistent: true,
+		SampleRate: c.sampleRate,
+		StartedAt:  time.Now(),
+		Targets:    make([]TargetDrift, len(c.targets)),
+	}
+
+	collections, err := c.source.ListCollections()
+	if err != nil {
+		return nil, fmt.Errorf("list collections of the source is failed with err %w", err)
+	}
+
+	for i, target := range c.targets {
+		td := &report.Targets[i]
+		td.Target = i
+		td.Drifts = []Drift{}
+
+		err = c.checkTarget(ctx, collections, checkedTarget{cli: target, route: c.routes[i], mapping: c.mappings[i]}, td)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			logrus.Errorf("check target %d is failed with err %v", i, err)
+			td.Error = err.Error()
+		}
+
+		td.Consistent = td.Error == "" && len(td.Drifts) == 0
+		report.Consistent = report.Consistent && td.Consistent
+	}
+
+	report.FinishedAt = time.Now()
+
+	return report, nil
+}
+
+// checkTarget compares the target with the collections of the source its route selects
+func (c *Checker) checkTarget(ctx context.Context, collections []string, target checkedTarget, td *TargetDrift) error {
+	targetCollections, err := target.cli.ListCollections()
+	if err != nil {
+		return fmt.Errorf("list collections is failed with err %w", err)
+	}
+
+	for _, collectionName := range targetCollections {
+		if !slices.ContainsFunc(collections, func(name string) bool {
+			return target.mapping.Collection(name) == collectionName
+		}) {
+			td.Drifts = append(td.Drifts, Drift{Kind: DriftExtraCollection, Collection: collectionName})
+		}
+	}
+
+	for _, collectionName := range collections {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if !target.route.Match(&MessageCDC{Action: CreateCollection, CollectionName: collectionName}) {
+			continue
+		}
+
+		if !slices.Contains(targetCollections, target.mapping.Collection(collectionName)) {
+			td.Drifts = append(td.Drifts, Drift{Kind: DriftMissingCollection, Collection: collectionName})
+			continue
+		}
+
+		err = c.checkCollection(ctx, collectionName, target, td)
+		if err != nil {
+			return fmt.Errorf("check collection %v is failed with err %w", collectionName, err)
+		}
+
+		td.Collections++
+	}
+
+	return nil
+}
+
+func (c *Checker) checkCollection(ctx context.Context, collectionName string, target checkedTarget, td *TargetDrift) error {
+	targetCollection := target.mapping.Collection(collectionName)
+
+	sourceParam, err := c.source.GetCollectionInfo(collectionName)
+	if err != nil {
+		return err
+	}
+
+	targetParam, err := target.cli.GetCollectionInfo(targetCollection)
+	if err != nil {
+		return err
+	}
+
+	if describeCollection(sourceParam) != describeCollection(targetParam) {
+		td.Drifts = append(td.Drifts, Drift{
+			Kind:       DriftCollectionParams,
+			Collection: collectionName,
+			Source:     describeCollection(sourceParam),
+			Target:     describeCollection(targetParam),
+		})
+	}
+
+	err = c.checkIndex(ctx, collectionName, target, td)
+	if err != nil {
+		return err
+	}
+
+	sourceStats, err := c.source.ShowCollectionInfo(collectionName)
+	if err != nil {
+		return err
+	}
+
+	targetStats, err := target.cli.ShowCollectionInfo(targetCollection)
+	if err != nil {
+		return err
+	}
+
+	// the row counts only compare when every partition is routed to the target
+	if sourceStats.RowCount != targetStats.RowCount && !slices.ContainsFunc(sourceStats.Partitions, func(partition PartitionStats) bool {
+		return !routedPartition(target.route, collectionName, partition.Tag)
+	}) {
+		td.Drifts = append(td.Drifts, Drift{
+			Kind:       DriftRowCount,
+			Collection: collectionName,
+			Source:     strconv.FormatInt(sourceStats.RowCount, 10),
+			Target:     strconv.FormatInt(targetStats.RowCount, 10),
+		})
+	}
+
+	for _, partition := range targetStats.Partitions {
+		if !slices.ContainsFunc(sourceStats.Partitions, func(source PartitionStats) bool {
+			return target.mapping.Partition(collectionName, source.Tag) == partition.Tag
+		}) {
+			td.Drifts = append(td.Drifts, Drift{Kind: DriftExtraPartition, Collection: collectionName, Partition: partition.Tag})
+		}
+	}
+
+	for _, partition := range sourceStats.Partitions {
+		if !routedPartition(target.route, collectionName, partition.Tag) {
+			continue
+		}
+
+		targetPartition := findPartition(targetStats, target.mapping.Partition(collectionName, partition.Tag))
+		if targetPartition == nil {
+			td.Drifts = append(td.Drifts, Drift{Kind: DriftMissingPartition, Collection: collectionName, Partition: partition.Tag})
+			continue
+		}
+
+		err = c.checkEntities(ctx, collectionName, partition, *targetPartition, target, td)
+		if err != nil {
+			return fmt.Errorf("check partition %v is failed with err %w", partition.Tag, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Checker) checkIndex(ctx context.Context, collectionName string, target checkedTarget, td *TargetDrift) error {
+	sourceIndex, err := c.source.GetIndexInfo(collectionName)
+	if err != nil {
+		return err
+	}
+
+	targetIndex, err := target.cli.GetIndexInfo(target.mapping.Collection(collectionName))
+	if err != nil {
+		return err
+	}
+
+	sourceNList, err := parseNList(sourceIndex.ExtraParams)
+	if err != nil {
+		return err
+	}
+
+	targetNList, err := parseNList(targetIndex.ExtraParams)
+	if err != nil {
+		return err
+	}
+
+	source, dest := describeIndex(sourceIndex.IndexType, sourceNList), describeIndex(targetIndex.IndexType, targetNList)
+	if source == dest {
+		return nil
+	}
+
+	td.Drifts = append(td.Drifts, Drift{Kind: DriftIndex, Collection: collectionName, Source: source, Target: dest})
+
+	if c.publisher == nil {
+		return nil
+	}
+
+	// dropping the index of Milvus 1.x restores the FLAT default and creating one replaces the current, so both are
+	// idempotent on the targets that already match the source
+	if sourceIndex.IndexType == milvus.FLAT || sourceIndex.IndexType == milvus.INVALID {
+		_, err = c.publisher.PublishDropIndex(ctx, collectionName)
+	} else {
+		_, err = c.publisher.PublishCreateIndex(ctx, collectionName, sourceIndex.IndexType, sourceNList)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	td.Corrections++
+
+	return nil
+}
+
+// checkEntities compares the sampled ids of a partition and the vectors of the ids both sides hold. The ids found
+// drifting are read again after the settle delay and only the ones still drifting are reported
+func (c *Checker) checkEntities(ctx context.Context, collectionName string, source, partition PartitionStats, target checkedTarget, td *TargetDrift) error {
+	sourceIds, err := c.ids(c.source, collectionName, source)
+	if err != nil {
+		return err
+	}
+
+	targetIds, err := c.ids(target.cli, target.mapping.Collection(collectionName), partition)
+	if err != nil {
+		return err
+	}
+
+	td.Entities += int64(len(sourceIds))
+
+	var common, suspects []int64
+	for id := range sourceIds {
+		if _, ok := targetIds[id]; ok {
+			common = append(common, id)
+		} else {
+			suspects = append(suspects, id)
+		}
+	}
+
+	for id := range targetIds {
+		if _, ok := sourceIds[id]; !ok {
+			suspects = append(suspects, id)
+		}
+	}
+
+	partitionTag := source.Tag
+	if partitionTag == DefaultPartitionTag {
+		partitionTag = ""
+	}
+
+	slices.Sort(common)
+
+	drifting, err := c.compare(ctx, collectionName, partitionTag, common, target)
+	if err != nil {
+		return err
+	}
+
+	suspects = append(suspects, drifting.ids()...)
+	if len(suspects) == 0 {
+		return nil
+	}
+
+	select {
+	case <-time.After(c.settle):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	slices.Sort(suspects)
+
+	drifting, err = c.compare(ctx, collectionName, partitionTag, suspects, target)
+	if err != nil {
+		return err
+	}
+
+	for _, drift := range []Drift{
+		{Kind: DriftMissingEntities, Ids: drifting.missing},
+		{Kind: DriftExtraEntities, Ids: drifting.extra},
+		{Kind: DriftVectors, Ids: drifting.mismatched},
+	} {
+		if len(drift.Ids) == 0 {
+			continue
+		}
+
+		drift.Collection, drift.Partition = collectionName, source.Tag
+		td.Drifts = append(td.Drifts, drift)
+	}
+
+	if c.publisher == nil {
+		return nil
+	}
+
+	return c.correct(ctx, collectionName, partitionTag, drifting, td)
+}
+
+// entityDrift classifies the ids whose entities differ, vectors holds the source vectors of the missing and
+// mismatched ones
+type entityDrift struct {
+	missing    []int64
+	extra      []int64
+	mismatched []int64
+	vectors    map[int64][]float32
+}
+
+func (e *entityDrift) ids() []int64 {
+	return slices.Concat(e.missing, e.extra, e.mismatched)
+}
+
+// compare reads the entities of ids from both sides in batches, an id without data is one the side does not hold
+func (c *Checker) compare(ctx context.Context, collectionName, partitionTag string, ids []int64, target checkedTarget) (*entityDrift, error) {
+	targetCollection, targetPartition := target.mapping.Collection(collectionName), target.mapping.Partition(collectionName, partitionTag)
+
+	drift := &entityDrift{vectors: make(map[int64][]float32)}
+
+	for start := 0; start < len(ids); start += c.batchSize {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		batch := ids[start:min(start+c.batchSize, len(ids))]
+
+		sourceEntities, err := c.entities(c.source, collectionName, partitionTag, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		targetEntities, err := c.entities(target.cli, targetCollection, targetPartition, batch)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, id := range batch {
+			sourceSum, inSource := entityChecksum(sourceEntities[i])
+			targetSum, inTarget := entityChecksum(targetEntities[i])
+
+			switch {
+			case inSource && !inTarget:
+				drift.missing = append(drift.missing, id)
+			case !inSource && inTarget:
+				drift.extra = append(drift.extra, id)
+			case inSource && inTarget && sourceSum != targetSum:
+				drift.mismatched = append(drift.mismatched, id)
+			default:
+				continue
+			}
+
+			if inSource {
+				drift.vectors[id] = sourceEntities[i].FloatData
+			}
+		}
+	}
+
+	return drift, nil
+}
+
+// entities returns one entity per id, Milvus returns no entity at all when none of the ids exist
+func (c *Checker) entities(cli IMilvusSnapshotClientInterface, collectionName, partitionTag string, ids []int64) ([]milvus.Entity, error) {
+	entities, err := cli.GetEntityByID(collectionName, partitionTag, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entities) == 0 {
+		return make([]milvus.Entity, len(ids)), nil
+	}
+
+	if len(entities) != len(ids) {
+		return nil, fmt.Errorf("%d entities are returned for %d ids", len(entities), len(ids))
+	}
+
+	return entities, nil
+}
+
+// correct deletes the extra entities and replaces the missing and mismatched ones with the vectors of the source
+func (c *Checker) correct(ctx context.Context, collectionName, partitionTag string, drift *entityDrift, td *TargetDrift) error {
+	extra := drift.extra
+
+	var (
+		ids     []int64
+		vectors [][]float32
+	)
+
+	for _, id := range slices.Concat(drift.missing, drift.mismatched) {
+		vector := drift.vectors[id]
+		if len(vector) == 0 {
+			logrus.Errorf("the entity %d of collection %v has a binary vector, it is not corrected", id, collectionName)
+			continue
+		}
+
+		ids = append(ids, id)
+		vectors = append(vectors, vector)
+	}
+
+	for start := 0; start < len(extra); start += c.batchSize {
+		_, err := c.publisher.PublishDelete(ctx, collectionName, partitionTag, extra[start:min(start+c.batchSize, len(extra))]...)
+		if err != nil {
+			return err
+		}
+
+		td.Corrections++
+	}
+
+	for start := 0; start < len(ids); start += c.batchSize {
+		end := min(start+c.batchSize, len(ids))
+
+		_, err := c.publisher.PublishDelete(ctx, collectionName, partitionTag, ids[start:end]...)
+		if err != nil {
+			return err
+		}
+
+		_, err = c.publisher.PublishInsertBatch(ctx, collectionName, partitionTag, ids[start:end], vectors[start:end])
+		if err != nil {
+			return err
+		}
+
+		td.Corrections += 2
+	}
+
+	return nil
+}
+
+// ids lists the sampled ids of a partition segment by segment
+func (c *Checker) ids(cli IMilvusSnapshotClientInterface, collectionName string, partition PartitionStats) (map[int64]struct{}, error) {
+	ids := make(map[int64]struct{})
+	for _, segment := range partition.Segments {
+		segmentIds, err := cli.ListIDInSegment(collectionName, segment.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range segmentIds {
+			if c.sampled(id) {
+				ids[id] = struct{}{}
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+func (c *Checker) sampled(id int64) bool {
+	if c.sampleRate >= 1 {
+		return true
+	}
+
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(id))
+
+	hash := fnv.New64a()
+	_, _ = hash.Write(b[:])
+
+	return float64(hash.Sum64()%1000000) < c.sampleRate*1000000
+}
+
+// entityChecksum hashes the vector of the entity, it reports false when the entity holds no vector
+func entityChecksum(entity milvus.Entity) (uint64, bool) {
+	if len(entity.FloatData) == 0 && len(entity.BinaryData) == 0 {
+		return 0, false
+	}
+
+	hash := fnv.New64a()
+
+	var b [4]byte
+	for _, f := range entity.FloatData {
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(f))
+		_, _ = hash.Write(b[:])
+	}
+
+	_, _ = hash.Write(entity.BinaryData)
+
+	return hash.Sum64(), true
+}
+
+// routedPartition reports whether the entities of the partition are routed, the _default partition always exists
+func routedPartition(route Route, collectionName, partitionTag string) bool {
+	if partitionTag == DefaultPartitionTag {
+		return route.Match(&MessageCDC{Action: Insert, CollectionName: collectionName})
+	}
+
+	return route.Match(&MessageCDC{Action: CreatePartition, CollectionName: collectionName, PartitionTag: partitionTag})
+}
+
+func findPartition(stats *CollectionStats, tag string) *PartitionStats {
+	for i := range stats.Partitions {
+		if stats.Partitions[i].Tag == tag {
+			return &stats.Partitions[i]
+		}
+	}
+
+	return nil
+}
+
+func describeCollection(param milvus.CollectionParam) string {
+	return fmt.Sprintf("dimension=%d index_file_size=%d metric_type=%d", param.Dimension, param.IndexFileSize, param.MetricType)
+}
+
+func describeIndex(indexType milvus.IndexType, nList int64) string {
+	return fmt.Sprintf("index_type=%d nlist=%d", indexType, nList)
+}
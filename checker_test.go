@@ -0,0 +1,177 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// unreadableSnapshot is a replica that cannot be listed
+type unreadableSnapshot struct {
+	*fakeSnapshot
+}
+
+func (unreadableSnapshot) ListCollections() ([]string, error) {
+	return nil, errors.New("milvus is not connected")
+}
+
+// drifts returns the drifts of the target by kind
+func drifts(t *testing.T, report *DriftReport, target int) map[string]Drift {
+	t.Helper()
+
+	kinds := make(map[string]Drift)
+	for _, drift := range report.Targets[target].Drifts {
+		kinds[drift.Kind] = drift
+	}
+
+	return kinds
+}
+
+func TestCheckerReportsConsistentReplicas(t *testing.T) {
+	source, target := &fakeSnapshot{}, &fakeSnapshot{}
+	for _, snapshot := range []*fakeSnapshot{source, target} {
+		snapshot.add("c", "", 1, 2)
+		snapshot.add("c", "p", 3)
+	}
+
+	report, err := NewChecker(source, []IMilvusSnapshotClientInterface{target}, WithCheckSettle(0)).Check(context.Background())
+	if err != nil {
+		t.Fatalf("check is failed with err %v", err)
+	}
+
+	if !report.Consistent || report.Targets[0].Collections != 1 || report.Targets[0].Entities != 3 {
+		t.Fatalf("the report is %+v", report)
+	}
+}
+
+func TestCheckerReportsEveryDrift(t *testing.T) {
+	source, target := &fakeSnapshot{}, &fakeSnapshot{}
+	source.add("c", "", 1, 2, 3)
+	source.add("c", "p")
+	source.add("missing", "")
+	target.add("c", "", 1, 2, 4)
+	target.add("extra", "")
+
+	target.collections["c"].vectors[DefaultPartitionTag][2] = []float32{20}
+	target.collections["c"].index.IndexType = 2
+	target.collections["c"].index.ExtraParams = `{"nlist": 1024}`
+
+	report, err := NewChecker(source, []IMilvusSnapshotClientInterface{target}, WithCheckSettle(0)).Check(context.Background())
+	if err != nil {
+		t.Fatalf("check is failed with err %v", err)
+	}
+
+	if report.Consistent || report.Targets[0].Consistent {
+		t.Fatalf("the drifting target is reported consistent")
+	}
+
+	kinds := drifts(t, report, 0)
+	for kind, want := range map[string]Drift{
+		DriftMissingCollection: {Collection: "missing"},
+		DriftExtraCollection:   {Collection: "extra"},
+		DriftMissingPartition:  {Collection: "c", Partition: "p"},
+		DriftIndex:             {Collection: "c", Source: "index_type=1 nlist=0", Target: "index_type=2 nlist=1024"},
+		DriftMissingEntities:   {Collection: "c", Partition: DefaultPartitionTag, Ids: []int64{3}},
+		DriftExtraEntities:     {Collection: "c", Partition: DefaultPartitionTag, Ids: []int64{4}},
+		DriftVectors:           {Collection: "c", Partition: DefaultPartitionTag, Ids: []int64{2}},
+	} {
+		drift, ok := kinds[kind]
+		if !ok || drift.Collection != want.Collection || drift.Partition != want.Partition ||
+			drift.Source != want.Source || drift.Target != want.Target || !slices.Equal(drift.Ids, want.Ids) {
+			t.Errorf("the %v drift is %+v, wanted %+v", kind, drift, want)
+		}
+	}
+}
+
+func TestCheckerPublishesCorrections(t *testing.T) {
+	source, target := &fakeSnapshot{}, &fakeSnapshot{}
+	source.add("c", "", 1, 2)
+	target.add("c", "", 1, 3)
+	target.collections["c"].vectors[DefaultPartitionTag][1] = []float32{10}
+
+	transport := &recordingTransport{}
+	checker := NewChecker(source, []IMilvusSnapshotClientInterface{target}, WithCheckSettle(0),
+		WithCorrections(NewPublisher(transport)))
+
+	report, err := checker.Check(context.Background())
+	if err != nil {
+		t.Fatalf("check is failed with err %v", err)
+	}
+
+	sent := transport.Sent()
+	if report.Targets[0].Corrections != 3 || len(sent) != 3 {
+		t.Fatalf("%d corrections are reported and %d sent: %+v", report.Targets[0].Corrections, len(sent), sent)
+	}
+
+	// the extra entity is deleted, the missing and mismatched ones are deleted and inserted with the source vectors
+	if sent[0].Action != Delete || !slices.Equal(sent[0].Ids, []int64{3}) ||
+		sent[1].Action != Delete || !slices.Equal(sent[1].Ids, []int64{2, 1}) ||
+		sent[2].Action != Insert || !slices.Equal(sent[2].Ids, []int64{2, 1}) {
+		t.Fatalf("the corrections are %+v", sent)
+	}
+
+	if !slices.Equal(sent[2].Vectors, []string{EncodeVector([]float32{2}), EncodeVector([]float32{1})}) {
+		t.Fatalf("the corrections insert the vectors %v", sent[2].Vectors)
+	}
+}
+
+func TestCheckerFollowsTheRouteAndNameMappingOfTheTarget(t *testing.T) {
+	source, target := &fakeSnapshot{}, &fakeSnapshot{}
+	source.add("tenant_a", "p", 1)
+	source.add("tenant_b", "", 2)
+	target.add("staging_tenant_a", "staging_p", 1)
+
+	checker := NewChecker(source, []IMilvusSnapshotClientInterface{target}, WithCheckSettle(0),
+		WithTargetRoute(0, Route{Include: []RouteRule{{Collections: []string{"tenant_a"}}}}),
+		WithTargetMapping(0, NameMapping{
+			CollectionRules: []NameRule{{Match: "*", Template: "staging_{collection}"}},
+			PartitionRules:  []NameRule{{Match: "*", Template: "staging_{partition}"}},
+		}))
+
+	report, err := checker.Check(context.Background())
+	if err != nil {
+		t.Fatalf("check is failed with err %v", err)
+	}
+
+	if !report.Consistent || report.Targets[0].Collections != 1 || report.Targets[0].Entities != 1 {
+		t.Fatalf("the report is %+v", report.Targets[0])
+	}
+}
+
+func TestCheckerSamplesTheSameIdsOnEverySide(t *testing.T) {
+	source, target := &fakeSnapshot{}, &fakeSnapshot{}
+	ids := make([]int64, 0, 1000)
+	for id := int64(1); id <= 1000; id++ {
+		ids = append(ids, id)
+	}
+
+	source.add("c", "", ids...)
+	target.add("c", "", ids...)
+
+	report, err := NewChecker(source, []IMilvusSnapshotClientInterface{target}, WithCheckSettle(0),
+		WithSampleRate(0.1)).Check(context.Background())
+	if err != nil {
+		t.Fatalf("check is failed with err %v", err)
+	}
+
+	if entities := report.Targets[0].Entities; !report.Consistent || entities < 50 || entities > 150 {
+		t.Fatalf("%d entities are sampled, the report is consistent %v", entities, report.Consistent)
+	}
+}
+
+func TestCheckerReportsAnUnreadableTargetAndChecksTheOthers(t *testing.T) {
+	source, target := &fakeSnapshot{}, &fakeSnapshot{}
+	source.add("c", "", 1)
+	target.add("c", "", 1)
+
+	report, err := NewChecker(source, []IMilvusSnapshotClientInterface{unreadableSnapshot{target}, target},
+		WithCheckSettle(0)).Check(context.Background())
+	if err != nil {
+		t.Fatalf("check is failed with err %v", err)
+	}
+
+	if report.Consistent || report.Targets[0].Error == "" || !report.Targets[1].Consistent {
+		t.Fatalf("the report is %+v", report.Targets)
+	}
+}
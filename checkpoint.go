@@ -0,0 +1,79 @@
+package milvus_cdc
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+)
+
+// checkpoint caches the high water marks of one target during a batch, messages without a sequence are always applied.
+// Once a message of a collection failed the mark of the collection stops moving for the rest of the batch, otherwise
+// the failed message would be skipped as applied when it is delivered again
+type checkpoint struct {
+	store  ICheckpointStore
+	target string
+	marks  map[string]int64
+	failed map[string]bool
+}
+
+func (s *syncer) newCheckpoint(idx int) *checkpoint {
+	return &checkpoint{
+		store:  s.checkpoint,
+		target: s.targetId(idx),
+		marks:  make(map[string]int64),
+		failed: make(map[string]bool),
+	}
+}
+
+func (c *checkpoint) applied(message *MessageCDC) bool {
+	if c.store == nil || message.Sequence <= 0 {
+		return false
+	}
+
+	mark, ok := c.marks[message.CollectionName]
+	if !ok {
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+		defer cancel()
+
+		var err error
+		mark, err = c.store.Get(ctx, c.target, message.CollectionName)
+		if err != nil {
+			// applying twice is safer than skipping an event that was never applied
+			logrus.Errorf("get checkpoint of collection %v and target %v is failed with err %v", message.CollectionName, c.target, err)
+			return false
+		}
+
+		c.marks[message.CollectionName] = mark
+	}
+
+	return message.Sequence <= mark
+}
+
+func (c *checkpoint) commit(messages []*MessageCDC) {
+	if c.store == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	for _, message := range messages {
+		if message.Sequence <= 0 || message.Sequence <= c.marks[message.CollectionName] || c.failed[message.CollectionName] {
+			continue
+		}
+
+		err := c.store.Set(ctx, c.target, message.CollectionName, message.Sequence)
+		if err != nil {
+			logrus.Errorf("set checkpoint of collection %v and target %v is failed with err %v", message.CollectionName, c.target, err)
+			continue
+		}
+
+		c.marks[message.CollectionName] = message.Sequence
+	}
+}
+
+func (c *checkpoint) fail(messages []*MessageCDC) {
+	for _, message := range messages {
+		c.failed[message.CollectionName] = true
+	}
+}
@@ -0,0 +1,54 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSyncBatchKeepsTheCheckpointBelowAFailedMessage(t *testing.T) {
+	_, client := newTestRedis(t)
+	store := NewRedisCheckpointStore(client, "")
+	target := &fakeMilvus{}
+	target.setFail(func(call fakeCall) error {
+		if call.Ids[0] == 2 {
+			return &MilvusError{Op: "fake", Class: ErrorClassPermanent, Err: errors.New("illegal vector")}
+		}
+
+		return nil
+	})
+
+	s := newSyncer([]IMilvusClientInterface{target}, newOptions(WithCheckpointStore(store), WithBatching(1, 0)))
+	messages := []*MessageCDC{
+		{Action: Insert, CollectionName: "c", Id: 1, Sequence: 1, Vector: EncodeVector([]float32{1})},
+		{Action: Insert, CollectionName: "c", Id: 2, Sequence: 2, Vector: EncodeVector([]float32{2})},
+		{Action: Insert, CollectionName: "c", Id: 3, Sequence: 3, Vector: EncodeVector([]float32{3})},
+	}
+
+	s.syncBatch(context.Background(), messages, 0)
+
+	mark, err := store.Get(context.Background(), s.targetId(0), "c")
+	if err != nil {
+		t.Fatalf("get checkpoint is failed with err %v", err)
+	}
+
+	if mark != 1 {
+		t.Fatalf("the checkpoint is %d, wanted 1 below the failed sequence 2", mark)
+	}
+}
+
+func TestRedisCheckpointStoreKeysByTargetName(t *testing.T) {
+	_, client := newTestRedis(t)
+	store := NewRedisCheckpointStore(client, "")
+
+	s := newSyncer([]IMilvusClientInterface{&fakeMilvus{}, &fakeMilvus{}},
+		newOptions(WithCheckpointStore(store), WithTargetName(1, "replica")))
+	s.syncBatch(context.Background(), []*MessageCDC{
+		{Action: Insert, CollectionName: "c", Id: 1, Sequence: 7, Vector: EncodeVector([]float32{1})},
+	}, 1)
+
+	mark, err := client.HGet(context.Background(), DefaultCheckpointKey+":replica", "c").Int64()
+	if err != nil || mark != 7 {
+		t.Fatalf("the checkpoint of the replica is %d with err %v", mark, err)
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/nats-io/nats.go"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/sirupsen/logrus"
+	cdc "github.com/warriors-vn/milvus-cdc"
+)
+
+// check prints the drift report of the check section as JSON, it exits with 2 when a target drifted
+func check(args []string) {
+	flags := flag.NewFlagSet("check", flag.ExitOnError)
+	path := flags.String("config", "milvus-cdc.yaml", "path of the YAML or JSON config file")
+	_ = flags.Parse(args)
+
+	config, err := LoadCheckConfig(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	d := &daemon{
+		config: config,
+	}
+
+	report, err := d.check()
+	d.close()
+
+	if err != nil {
+		logrus.Fatalf("check milvus-cdc is failed with err %v", err)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	err = encoder.Encode(report)
+	if err != nil {
+		logrus.Fatalf("print the drift report is failed with err %v", err)
+	}
+
+	if !report.Consistent {
+		os.Exit(2)
+	}
+}
+
+func (d *daemon) check() (*cdc.DriftReport, error) {
+	config := d.config.Check
+
+	source, err := d.snapshotClient("check.source", config.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make([]cdc.IMilvusSnapshotClientInterface, 0, len(config.Targets))
+	for i, target := range config.Targets {
+		conn, err := d.snapshotClient(fmt.Sprintf("check.targets[%d]", i), target)
+		if err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, conn)
+	}
+
+	opts := []cdc.CheckerOption{
+		cdc.WithSampleRate(config.SampleRate),
+		cdc.WithCheckBatchSize(config.BatchSize),
+	}
+
+	for i, target := range config.Targets {
+		if target.Route != nil {
+			opts = append(opts, cdc.WithTargetRoute(i, *target.Route))
+		}
+
+		if target.Names != nil {
+			opts = append(opts, cdc.WithTargetMapping(i, target.Names.mapping()))
+		}
+	}
+
+	if config.Settle > 0 {
+		opts = append(opts, cdc.WithCheckSettle(config.Settle))
+	}
+
+	if config.Corrections != nil {
+		publisher, err := d.publisher(*config.Corrections)
+		if err != nil {
+			return nil, err
+		}
+
+		// closed before the connection it publishes on
+		defer publisher.Close()
+
+		opts = append(opts, cdc.WithCorrections(publisher))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return cdc.NewChecker(source, targets, opts...).Check(ctx)
+}
+
+func (d *daemon) snapshotClient(path string, target TargetConfig) (cdc.IMilvusSnapshotClientInterface, error) {
+	conn, err := d.connect(path, target)
+	if err != nil {
+		return nil, err
+	}
+
+	d.closers = append(d.closers, conn)
+
+	snapshot, ok := conn.(cdc.IMilvusSnapshotClientInterface)
+	if !ok {
+		return nil, fmt.Errorf("%s does not support snapshots", path)
+	}
+
+	return snapshot, nil
+}
+
+// publisher connects to the broker of the subscription and returns a publisher sending to its channel
+func (d *daemon) publisher(subscription SubscriptionConfig) (*cdc.Publisher, error) {
+	switch subscription.Broker {
+	case cdc.Redis:
+		redisCli, err := d.redis()
+		if err != nil {
+			return nil, err
+		}
+
+		return cdc.NewRedisPublisher(redisCli, subscription.Channel, subscription.Pattern)
+	case cdc.Kafka:
+		return cdc.NewKafkaPublisher(d.config.Kafka.Brokers, subscription.Channel), nil
+	case cdc.Nats:
+		conn, err := nats.Connect(d.config.Nats.URL)
+		if err != nil {
+			return nil, fmt.Errorf("connect nats is failed with err %v", err)
+		}
+
+		d.closers = append(d.closers, closerFunc(func() error {
+			conn.Close()
+			return nil
+		}))
+
+		return cdc.NewNatsPublisher(conn, subscription.Channel)
+	default:
+		conn, err := amqp.Dial(d.config.RabbitMQ.URL)
+		if err != nil {
+			return nil, fmt.Errorf("connect rabbitmq is failed with err %v", err)
+		}
+
+		d.closers = append(d.closers, conn)
+
+		return cdc.NewRabbitMQPublisher(conn, subscription.Channel, subscription.Pattern)
+	}
+}
@@ -0,0 +1,633 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	cdc "github.com/warriors-vn/milvus-cdc"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	MilvusV1 = 1
+	MilvusV2 = 2
+)
+
+// Config describes the daemon, it is read from YAML and since YAML is a superset of JSON a JSON file works as well
+type Config struct {
+	Redis         *RedisConfig         `yaml:"redis"`
+	Kafka         *KafkaConfig         `yaml:"kafka"`
+	Nats          *NatsConfig          `yaml:"nats"`
+	RabbitMQ      *RabbitMQConfig      `yaml:"rabbitmq"`
+	Targets       []TargetConfig       `yaml:"targets"`
+	Subscriptions []SubscriptionConfig `yaml:"subscriptions"`
+	Consumer      ConsumerConfig       `yaml:"consumer"`
+	Retry         *RetryConfig         `yaml:"retry"`
+	Batching      BatchingConfig       `yaml:"batching"`
+	Metrics       *MetricsConfig       `yaml:"metrics"`
+	Health        *HealthConfig        `yaml:"health"`
+	Bootstrap     *BootstrapConfig     `yaml:"bootstrap"`
+	Check         *CheckConfig         `yaml:"check"`
+	EventLog      *EventLogConfig      `yaml:"event_log"`
+	// ShutdownTimeout bounds how long the daemon waits for the brokers to stop after SIGINT or SIGTERM
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+}
+
+type RedisConfig struct {
+	URL string `yaml:"url"`
+}
+
+type KafkaConfig struct {
+	Brokers []string `yaml:"brokers"`
+}
+
+type NatsConfig struct {
+	URL string `yaml:"url"`
+}
+
+type RabbitMQConfig struct {
+	URL string `yaml:"url"`
+}
+
+type TargetConfig struct {
+	// Name identifies the target in its checkpoints, consumer groups and queues, it defaults to host:port and is
+	// required when two targets share an address
+	Name    string        `yaml:"name"`
+	Host    string        `yaml:"host"`
+	Port    string        `yaml:"port"`
+	Version int           `yaml:"version"`
+	Timeout time.Duration `yaml:"timeout"`
+	TLS     *TLSConfig    `yaml:"tls"`
+	// Route selects the messages the target receives, it receives every message when it is empty
+	Route *cdc.Route `yaml:"route"`
+	// Names renames the collections and partitions on the target
+	Names *NameMappingConfig `yaml:"names"`
+}
+
+type NameMappingConfig struct {
+	Collections     map[string]string `yaml:"collections"`
+	Partitions      map[string]string `yaml:"partitions"`
+	CollectionRules []cdc.NameRule    `yaml:"collection_rules"`
+	PartitionRules  []cdc.NameRule    `yaml:"partition_rules"`
+}
+
+type TLSConfig struct {
+	CAFile             string `yaml:"ca_file"`
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	ServerName         string `yaml:"server_name"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+type SubscriptionConfig struct {
+	Broker  string `yaml:"broker"`
+	Channel string `yaml:"channel"`
+	Pattern string `yaml:"pattern"`
+}
+
+type ConsumerConfig struct {
+	Group string `yaml:"group"`
+	Name  string `yaml:"name"`
+}
+
+type RetryConfig struct {
+	MaxAttempts    int           `yaml:"max_attempts"`
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	MaxBackoff     time.Duration `yaml:"max_backoff"`
+	Multiplier     float64       `yaml:"multiplier"`
+	Jitter         float64       `yaml:"jitter"`
+}
+
+type BatchingConfig struct {
+	Size    int           `yaml:"size"`
+	Latency time.Duration `yaml:"latency"`
+}
+
+type MetricsConfig struct {
+	// Listen is the address of the HTTP listener serving /metrics
+	Listen string `yaml:"listen"`
+	// Interval is how often the brokers read the queue depths, lags and dead letters
+	Interval time.Duration `yaml:"interval"`
+}
+
+type HealthConfig struct {
+	// Listen is the address of the HTTP listener serving /healthz and /readyz
+	Listen        string        `yaml:"listen"`
+	DownThreshold time.Duration `yaml:"down_threshold"`
+	Interval      time.Duration `yaml:"interval"`
+	// RequiredTargets lists the indexes of the targets readiness depends on, every target is required when it is empty
+	RequiredTargets []int `yaml:"required_targets"`
+}
+
+// BootstrapConfig describes the bootstrap subcommand, it copies the source into the target and then streams the
+// redis channel to the target with the consumer group, which must not exist yet
+type BootstrapConfig struct {
+	Source  TargetConfig `yaml:"source"`
+	Target  TargetConfig `yaml:"target"`
+	Channel string       `yaml:"channel"`
+}
+
+// CheckConfig describes the check subcommand, it compares the targets with the source and prints the drift report.
+// Zero values keep the defaults of the checker
+type CheckConfig struct {
+	Source     TargetConfig   `yaml:"source"`
+	Targets    []TargetConfig `yaml:"targets"`
+	SampleRate float64        `yaml:"sample_rate"`
+	BatchSize  int            `yaml:"batch_size"`
+	Settle     time.Duration  `yaml:"settle"`
+	// Corrections is where the messages fixing the drifts are published, nothing is published when it is empty
+	Corrections *SubscriptionConfig `yaml:"corrections"`
+}
+
+// EventLogConfig records every message received by the daemon in segment files under Dir, the replay subcommand
+// reads them back
+type EventLogConfig struct {
+	Dir string `yaml:"dir"`
+	// SegmentSize is the size in bytes a segment reaches before a new one starts
+	SegmentSize int64 `yaml:"segment_size"`
+	Fsync       bool  `yaml:"fsync"`
+}
+
+func LoadConfig(path string) (*Config, error) {
+	return loadConfig(path, (*Config).Validate)
+}
+
+// LoadBootstrapConfig reads the config of the bootstrap subcommand, only the bootstrap section, redis and the shared
+// sections are used
+func LoadBootstrapConfig(path string) (*Config, error) {
+	return loadConfig(path, (*Config).ValidateBootstrap)
+}
+
+// LoadCheckConfig reads the config of the check subcommand, only the check section and the brokers are used
+func LoadCheckConfig(path string) (*Config, error) {
+	return loadConfig(path, (*Config).ValidateCheck)
+}
+
+// LoadReplayConfig reads the config of the replay subcommand, only the event log, the targets and the shared sections
+// are used
+func LoadReplayConfig(path string) (*Config, error) {
+	return loadConfig(path, (*Config).ValidateReplay)
+}
+
+func loadConfig(path string, validate func(*Config) error) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var config Config
+
+	err = yaml.Unmarshal(data, &config)
+	if err != nil {
+		return nil, fmt.Errorf("parse config %v is failed with err %v", path, err)
+	}
+
+	config.setDefaults()
+
+	err = validate(&config)
+	if err != nil {
+		return nil, fmt.Errorf("config %v is invalid:\n%w", path, err)
+	}
+
+	return &config, nil
+}
+
+func (c *Config) setDefaults() {
+	for i := range c.Targets {
+		c.Targets[i].setDefaults()
+	}
+
+	if c.Bootstrap != nil {
+		c.Bootstrap.Source.setDefaults()
+		c.Bootstrap.Target.setDefaults()
+	}
+
+	if c.Check != nil {
+		c.Check.Source.setDefaults()
+		for i := range c.Check.Targets {
+			c.Check.Targets[i].setDefaults()
+		}
+	}
+
+	if c.ShutdownTimeout == 0 {
+		c.ShutdownTimeout = cdc.DefaultTimeout
+	}
+}
+
+func (t *TargetConfig) setDefaults() {
+	if t.Version == 0 {
+		t.Version = MilvusV1
+	}
+
+	if t.Timeout == 0 {
+		t.Timeout = cdc.DefaultTimeout
+	}
+}
+
+// Validate reports every problem of the config at once, each one prefixed by the path of the offending field
+func (c *Config) Validate() error {
+	errs := c.validateBrokers()
+
+	if len(c.Targets) == 0 {
+		errs = append(errs, fmt.Errorf("targets is empty, at least one milvus target is required"))
+	}
+
+	for i, target := range c.Targets {
+		errs = append(errs, target.validate(fmt.Sprintf("targets[%d]", i))...)
+	}
+
+	errs = append(errs, validateTargetIds(c.Targets)...)
+
+	if len(c.Subscriptions) == 0 {
+		errs = append(errs, fmt.Errorf("subscriptions is empty, at least one subscription is required"))
+	}
+
+	for i, subscription := range c.Subscriptions {
+		errs = append(errs, c.validateSubscription(fmt.Sprintf("subscriptions[%d]", i), subscription)...)
+	}
+
+	errs = append(errs, c.validateShared(len(c.Targets))...)
+
+	return errors.Join(errs...)
+}
+
+// ValidateBootstrap is Validate for the bootstrap subcommand, the targets and subscriptions are not used by it
+func (c *Config) ValidateBootstrap() error {
+	var errs []error
+
+	if c.Redis == nil {
+		errs = append(errs, fmt.Errorf("redis is not configured, the bootstrap streams a redis channel"))
+	} else if c.Redis.URL == "" {
+		errs = append(errs, fmt.Errorf("redis.url is empty"))
+	}
+
+	if c.Bootstrap == nil {
+		return errors.Join(append(errs, fmt.Errorf("bootstrap is not configured"))...)
+	}
+
+	errs = append(errs, c.Bootstrap.Source.validate("bootstrap.source")...)
+	if c.Bootstrap.Source.Version != MilvusV1 {
+		errs = append(errs, fmt.Errorf("bootstrap.source.version %v is invalid, only version %v can be snapshotted", c.Bootstrap.Source.Version, MilvusV1))
+	}
+
+	errs = append(errs, c.Bootstrap.Target.validate("bootstrap.target")...)
+
+	if c.Bootstrap.Channel == "" {
+		errs = append(errs, fmt.Errorf("bootstrap.channel is empty"))
+	}
+
+	errs = append(errs, c.validateShared(1)...)
+
+	return errors.Join(errs...)
+}
+
+// ValidateReplay is Validate for the replay subcommand, the subscriptions are not used by it
+func (c *Config) ValidateReplay() error {
+	var errs []error
+
+	if c.EventLog == nil {
+		errs = append(errs, fmt.Errorf("event_log is not configured"))
+	}
+
+	if len(c.Targets) == 0 {
+		errs = append(errs, fmt.Errorf("targets is empty, at least one milvus target is required"))
+	}
+
+	for i, target := range c.Targets {
+		errs = append(errs, target.validate(fmt.Sprintf("targets[%d]", i))...)
+	}
+
+	errs = append(errs, validateTargetIds(c.Targets)...)
+
+	errs = append(errs, c.validateShared(len(c.Targets))...)
+
+	return errors.Join(errs...)
+}
+
+// ValidateCheck is Validate for the check subcommand, every milvus it reads must support snapshots
+func (c *Config) ValidateCheck() error {
+	if c.Check == nil {
+		return fmt.Errorf("check is not configured")
+	}
+
+	errs := c.validateBrokers()
+
+	errs = append(errs, c.Check.Source.validate("check.source")...)
+	if c.Check.Source.Version != MilvusV1 {
+		errs = append(errs, fmt.Errorf("check.source.version %v is invalid, only version %v can be checked", c.Check.Source.Version, MilvusV1))
+	}
+
+	if len(c.Check.Targets) == 0 {
+		errs = append(errs, fmt.Errorf("check.targets is empty, at least one milvus target is required"))
+	}
+
+	for i, target := range c.Check.Targets {
+		path := fmt.Sprintf("check.targets[%d]", i)
+
+		errs = append(errs, target.validate(path)...)
+		if target.Version != MilvusV1 {
+			errs = append(errs, fmt.Errorf("%s.version %v is invalid, only version %v can be checked", path, target.Version, MilvusV1))
+		}
+	}
+
+	if c.Check.SampleRate < 0 || c.Check.SampleRate > 1 {
+		errs = append(errs, fmt.Errorf("check.sample_rate %v is not between 0 and 1", c.Check.SampleRate))
+	}
+
+	if c.Check.BatchSize < 0 {
+		errs = append(errs, fmt.Errorf("check.batch_size %v is negative", c.Check.BatchSize))
+	}
+
+	if c.Check.Settle < 0 {
+		errs = append(errs, fmt.Errorf("check.settle %v is negative", c.Check.Settle))
+	}
+
+	if c.Check.Corrections != nil {
+		errs = append(errs, c.validateSubscription("check.corrections", *c.Check.Corrections)...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Config) validateBrokers() []error {
+	var errs []error
+
+	if c.Redis != nil && c.Redis.URL == "" {
+		errs = append(errs, fmt.Errorf("redis.url is empty"))
+	}
+
+	if c.Kafka != nil && len(c.Kafka.Brokers) == 0 {
+		errs = append(errs, fmt.Errorf("kafka.brokers is empty"))
+	}
+
+	if c.Nats != nil && c.Nats.URL == "" {
+		errs = append(errs, fmt.Errorf("nats.url is empty"))
+	}
+
+	if c.RabbitMQ != nil && c.RabbitMQ.URL == "" {
+		errs = append(errs, fmt.Errorf("rabbitmq.url is empty"))
+	}
+
+	return errs
+}
+
+// validateShared checks the sections used by the daemon and the bootstrap subcommand alike
+func (c *Config) validateShared(targets int) []error {
+	var errs []error
+
+	if c.Retry != nil {
+		if c.Retry.MaxAttempts < 0 {
+			errs = append(errs, fmt.Errorf("retry.max_attempts %v is negative", c.Retry.MaxAttempts))
+		}
+
+		if c.Retry.InitialBackoff < 0 || c.Retry.MaxBackoff < 0 {
+			errs = append(errs, fmt.Errorf("retry backoffs must not be negative"))
+		}
+
+		if c.Retry.Jitter < 0 || c.Retry.Jitter > 1 {
+			errs = append(errs, fmt.Errorf("retry.jitter %v is not between 0 and 1", c.Retry.Jitter))
+		}
+	}
+
+	if c.Metrics != nil {
+		if c.Metrics.Listen == "" {
+			errs = append(errs, fmt.Errorf("metrics.listen is empty"))
+		}
+
+		if c.Metrics.Interval < 0 {
+			errs = append(errs, fmt.Errorf("metrics.interval %v is negative", c.Metrics.Interval))
+		}
+	}
+
+	if c.Health != nil {
+		errs = append(errs, c.validateHealth(targets)...)
+	}
+
+	if c.EventLog != nil {
+		if c.EventLog.Dir == "" {
+			errs = append(errs, fmt.Errorf("event_log.dir is empty"))
+		}
+
+		if c.EventLog.SegmentSize < 0 {
+			errs = append(errs, fmt.Errorf("event_log.segment_size %v is negative", c.EventLog.SegmentSize))
+		}
+	}
+
+	if c.Batching.Size < 0 {
+		errs = append(errs, fmt.Errorf("batching.size %v is negative", c.Batching.Size))
+	}
+
+	if c.Batching.Latency < 0 {
+		errs = append(errs, fmt.Errorf("batching.latency %v is negative", c.Batching.Latency))
+	}
+
+	return errs
+}
+
+func (t TargetConfig) validate(path string) []error {
+	var errs []error
+
+	if t.Host == "" {
+		errs = append(errs, fmt.Errorf("%s.host is empty", path))
+	}
+
+	port, err := strconv.Atoi(t.Port)
+	if err != nil || port <= 0 || port > 65535 {
+		errs = append(errs, fmt.Errorf("%s.port %q is not a valid port", path, t.Port))
+	}
+
+	if t.Version != MilvusV1 && t.Version != MilvusV2 {
+		errs = append(errs, fmt.Errorf("%s.version %v is invalid, supported versions are %v and %v", path, t.Version, MilvusV1, MilvusV2))
+	}
+
+	if t.Timeout < 0 {
+		errs = append(errs, fmt.Errorf("%s.timeout %v is negative", path, t.Timeout))
+	}
+
+	if t.TLS != nil && (t.TLS.CertFile == "") != (t.TLS.KeyFile == "") {
+		errs = append(errs, fmt.Errorf("%s.tls.cert_file and %s.tls.key_file must be set together", path, path))
+	}
+
+	if t.Route != nil {
+		errs = append(errs, prefixErrors(path+".route.", t.Route.Validate())...)
+	}
+
+	if t.Names != nil {
+		errs = append(errs, prefixErrors(path+".names.", t.Names.mapping().Validate())...)
+	}
+
+	return errs
+}
+
+// id is the name of the target in its checkpoints, consumer groups and queues
+func (t TargetConfig) id() string {
+	if t.Name != "" {
+		return t.Name
+	}
+
+	return net.JoinHostPort(t.Host, t.Port)
+}
+
+// validateTargetIds reports the targets sharing an id, they would share their checkpoints and skip each other's events
+func validateTargetIds(targets []TargetConfig) []error {
+	var (
+		errs []error
+		ids  = make(map[string]int, len(targets))
+	)
+
+	for i, target := range targets {
+		first, ok := ids[target.id()]
+		if !ok {
+			ids[target.id()] = i
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("targets[%d] has the id %v of targets[%d], give the targets distinct names", i, target.id(), first))
+	}
+
+	return errs
+}
+
+// options returns the name, route and name mapping of the target for the broker index idx
+func (t TargetConfig) options(idx int) []cdc.Option {
+	opts := []cdc.Option{cdc.WithTargetName(idx, t.Name)}
+	if t.Route != nil {
+		opts = append(opts, cdc.WithRoute(idx, *t.Route))
+	}
+
+	if t.Names != nil {
+		opts = append(opts, cdc.WithNameMapping(idx, t.Names.mapping()))
+	}
+
+	return opts
+}
+
+func (n *NameMappingConfig) mapping() cdc.NameMapping {
+	return cdc.NameMapping{
+		Collections:     n.Collections,
+		Partitions:      n.Partitions,
+		CollectionRules: n.CollectionRules,
+		PartitionRules:  n.PartitionRules,
+	}
+}
+
+// prefixErrors prefixes every error joined in err with the path of its field
+func prefixErrors(prefix string, err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{fmt.Errorf("%s%w", prefix, err)}
+	}
+
+	errs := make([]error, 0, len(joined.Unwrap()))
+	for _, e := range joined.Unwrap() {
+		errs = append(errs, fmt.Errorf("%s%w", prefix, e))
+	}
+
+	return errs
+}
+
+func (c *Config) validateHealth(targets int) []error {
+	var errs []error
+
+	if c.Health.Listen == "" {
+		errs = append(errs, fmt.Errorf("health.listen is empty"))
+	}
+
+	if c.Health.DownThreshold < 0 {
+		errs = append(errs, fmt.Errorf("health.down_threshold %v is negative", c.Health.DownThreshold))
+	}
+
+	if c.Health.Interval < 0 {
+		errs = append(errs, fmt.Errorf("health.interval %v is negative", c.Health.Interval))
+	}
+
+	for i, target := range c.Health.RequiredTargets {
+		if target < 0 || target >= targets {
+			errs = append(errs, fmt.Errorf("health.required_targets[%d] %v is not the index of a target", i, target))
+		}
+	}
+
+	return errs
+}
+
+func (c *Config) validateSubscription(path string, subscription SubscriptionConfig) []error {
+	var errs []error
+
+	if subscription.Channel == "" {
+		errs = append(errs, fmt.Errorf("%s.channel is empty", path))
+	}
+
+	var (
+		configured bool
+		patterns   []string
+	)
+
+	switch subscription.Broker {
+	case cdc.Redis:
+		configured, patterns = c.Redis != nil, []string{cdc.PubSub, cdc.Queue, cdc.Stream}
+	case cdc.Kafka:
+		configured, patterns = c.Kafka != nil, []string{cdc.PubSub, cdc.Queue}
+	case cdc.Nats:
+		configured, patterns = c.Nats != nil, []string{cdc.PubSub, cdc.Queue}
+	case cdc.RabbitMQ:
+		configured, patterns = c.RabbitMQ != nil, []string{cdc.PubSub, cdc.Queue}
+	default:
+		return append(errs, fmt.Errorf("%s.broker %q is invalid, supported brokers are %v", path, subscription.Broker,
+			[]string{cdc.Redis, cdc.Kafka, cdc.Nats, cdc.RabbitMQ}))
+	}
+
+	if !configured {
+		errs = append(errs, fmt.Errorf("%s.broker %q is not configured", path, subscription.Broker))
+	}
+
+	for _, pattern := range patterns {
+		if pattern == subscription.Pattern {
+			return errs
+		}
+	}
+
+	return append(errs, fmt.Errorf("%s.pattern %q is invalid for broker %v, supported patterns are %v", path,
+		subscription.Pattern, subscription.Broker, patterns))
+}
+
+func (t *TLSConfig) load() (*tls.Config, error) {
+	config := &tls.Config{
+		ServerName:         t.ServerName,
+		InsecureSkipVerify: t.InsecureSkipVerify,
+	}
+
+	if t.CAFile != "" {
+		ca, err := os.ReadFile(t.CAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("the ca file %v has no valid certificate", t.CAFile)
+		}
+
+		config.RootCAs = pool
+	}
+
+	if t.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(t.CertFile, t.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}
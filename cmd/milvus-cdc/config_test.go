@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	cdc "github.com/warriors-vn/milvus-cdc"
+)
+
+// writeConfig writes the config to a file of the test and returns its path
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, []byte(content), 0o600)
+	if err != nil {
+		t.Fatalf("write config is failed with err %v", err)
+	}
+
+	return path
+}
+
+func TestLoadConfigReadsTheExample(t *testing.T) {
+	config, err := LoadConfig("milvus-cdc.yaml")
+	if err != nil {
+		t.Fatalf("load config is failed with err %v", err)
+	}
+
+	if len(config.Targets) != 4 || config.Targets[0].Version != MilvusV1 || config.Targets[3].Version != MilvusV2 {
+		t.Fatalf("the targets are %+v", config.Targets)
+	}
+
+	if config.Targets[1].Timeout != cdc.DefaultTimeout || config.ShutdownTimeout != 30*time.Second {
+		t.Fatalf("the timeouts are %v and %v", config.Targets[1].Timeout, config.ShutdownTimeout)
+	}
+
+	if config.Targets[2].Name != "staging" || config.Targets[2].id() != "staging" || config.Targets[0].id() != "0.0.0.0:19530" {
+		t.Fatalf("the target ids are %v and %v", config.Targets[2].id(), config.Targets[0].id())
+	}
+
+	if config.Metrics.Interval != cdc.DefaultMetricsInterval {
+		t.Fatalf("the metrics interval is %v", config.Metrics.Interval)
+	}
+
+	for name, load := range map[string]func(string) (*Config, error){
+		"bootstrap": LoadBootstrapConfig,
+		"check":     LoadCheckConfig,
+		"replay":    LoadReplayConfig,
+	} {
+		_, err = load("milvus-cdc.yaml")
+		if err != nil {
+			t.Fatalf("load %v config is failed with err %v", name, err)
+		}
+	}
+}
+
+func TestLoadConfigReadsJSON(t *testing.T) {
+	path := writeConfig(t, "config.json", `{
+		"nats": {"url": "nats://localhost:4222"},
+		"targets": [{"host": "milvus", "port": "19530", "timeout": "5s"}],
+		"subscriptions": [{"broker": "nats", "channel": "cdc", "pattern": "queue"}]
+	}`)
+
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("load config is failed with err %v", err)
+	}
+
+	if config.Targets[0].Timeout != 5*time.Second || config.Subscriptions[0].Broker != cdc.Nats {
+		t.Fatalf("the config is %+v", config)
+	}
+}
+
+func TestLoadConfigReportsEveryProblem(t *testing.T) {
+	path := writeConfig(t, "config.yaml", `
+redis:
+  url: ""
+targets:
+  - host: ""
+    port: "70000"
+    version: 3
+    tls:
+      cert_file: client.pem
+subscriptions:
+  - broker: kafka
+    channel: ""
+    pattern: stream
+retry:
+  jitter: 2
+health:
+  listen: ":8080"
+  required_targets: [1]
+batching:
+  size: -1
+metrics:
+  interval: -1s
+`)
+
+	_, err := LoadConfig(path)
+	if err == nil {
+		t.Fatalf("an invalid config is loaded")
+	}
+
+	for _, want := range []string{
+		"redis.url is empty",
+		"targets[0].host is empty",
+		`targets[0].port "70000" is not a valid port`,
+		"targets[0].version 3 is invalid",
+		"targets[0].tls.cert_file and targets[0].tls.key_file must be set together",
+		"subscriptions[0].channel is empty",
+		`subscriptions[0].broker "kafka" is not configured`,
+		`subscriptions[0].pattern "stream" is invalid for broker kafka`,
+		"retry.jitter 2 is not between 0 and 1",
+		"health.required_targets[0] 1 is not the index of a target",
+		"batching.size -1 is negative",
+		"metrics.listen is empty",
+		"metrics.interval -1s is negative",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("the error does not report %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestLoadConfigRequiresNamesForTargetsSharingAnAddress(t *testing.T) {
+	config := `
+redis:
+  url: redis://localhost:6379
+targets:
+  - host: milvus
+    port: "19530"%s
+  - host: milvus
+    port: "19530"%s
+subscriptions:
+  - broker: redis
+    channel: cdc
+    pattern: stream
+`
+
+	_, err := LoadConfig(writeConfig(t, "config.yaml", fmt.Sprintf(config, "", "")))
+	if err == nil || !strings.Contains(err.Error(), "targets[1] has the id milvus:19530 of targets[0]") {
+		t.Fatalf("the targets sharing an address are loaded with err %v", err)
+	}
+
+	_, err = LoadConfig(writeConfig(t, "config.yaml", fmt.Sprintf(config, "\n    name: production", "\n    name: staging")))
+	if err != nil {
+		t.Fatalf("load config of the named targets is failed with err %v", err)
+	}
+}
+
+func TestLoadConfigRejectsUnknownBrokersAndEmptySections(t *testing.T) {
+	path := writeConfig(t, "config.yaml", `
+subscriptions:
+  - broker: pulsar
+    channel: cdc
+    pattern: queue
+`)
+
+	_, err := LoadConfig(path)
+	if err == nil || !strings.Contains(err.Error(), "targets is empty") ||
+		!strings.Contains(err.Error(), `subscriptions[0].broker "pulsar" is invalid`) {
+		t.Fatalf("the config is loaded with err %v", err)
+	}
+
+	_, err = LoadBootstrapConfig(path)
+	if err == nil || !strings.Contains(err.Error(), "bootstrap is not configured") {
+		t.Fatalf("the bootstrap config is loaded with err %v", err)
+	}
+}
+
+func TestTLSConfigLoadRejectsAnInvalidCA(t *testing.T) {
+	tlsConfig := &TLSConfig{CAFile: writeConfig(t, "ca.pem", "not a certificate"), ServerName: "milvus"}
+
+	_, err := tlsConfig.load()
+	if err == nil {
+		t.Fatalf("an invalid ca file is loaded")
+	}
+}
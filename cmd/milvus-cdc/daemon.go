@@ -0,0 +1,427 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/nats-io/nats.go"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/sirupsen/logrus"
+	cdc "github.com/warriors-vn/milvus-cdc"
+)
+
+type daemon struct {
+	config  *Config
+	worker  *cdc.WorkerCDC
+	metrics *cdc.Metrics
+	// eventLog records the messages of the brokers, it is closed once they stopped
+	eventLog *cdc.FileEventLog
+	closers  []io.Closer
+	// names lists the brokers given to the worker, they are the ones stopped on shutdown
+	names []string
+}
+
+func newDaemon(config *Config) (*daemon, error) {
+	d := &daemon{
+		config: config,
+	}
+
+	workerOpts := d.workerOptions()
+
+	if config.EventLog != nil {
+		eventLog, err := cdc.NewFileEventLog(config.EventLog.Dir, cdc.WithSegmentSize(config.EventLog.SegmentSize),
+			cdc.WithFsync(config.EventLog.Fsync))
+		if err != nil {
+			return nil, fmt.Errorf("open event_log.dir is failed with err %v", err)
+		}
+
+		d.eventLog = eventLog
+	}
+
+	brokers, err := d.brokers()
+	if err != nil {
+		d.close()
+		d.closeEventLog()
+		return nil, err
+	}
+
+	d.worker = cdc.NewWorkerCDC(cdc.NewBrokerFactoryWithOptions(brokers...), workerOpts...)
+
+	return d, nil
+}
+
+// newBootstrapDaemon copies the bootstrap source into the bootstrap target and returns a daemon streaming the
+// bootstrap channel to the target from where the copy ended. SIGINT or SIGTERM abort the copy
+func newBootstrapDaemon(config *Config) (*daemon, error) {
+	d := &daemon{
+		config: config,
+	}
+
+	workerOpts := d.workerOptions()
+
+	broker, err := d.bootstrap()
+	if err != nil {
+		d.close()
+		return nil, err
+	}
+
+	d.names = []string{cdc.Redis}
+	d.config.Subscriptions = []SubscriptionConfig{{
+		Broker:  cdc.Redis,
+		Channel: config.Bootstrap.Channel,
+		Pattern: cdc.Stream,
+	}}
+	d.worker = cdc.NewWorkerCDC(cdc.NewBrokerFactory(broker), workerOpts...)
+
+	return d, nil
+}
+
+func (d *daemon) bootstrap() (*cdc.RedisBroker, error) {
+	redisCli, err := d.redis()
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := d.connect("bootstrap.source", d.config.Bootstrap.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	// the source is only read by the copy
+	defer source.Close()
+
+	target, err := d.connect("bootstrap.target", d.config.Bootstrap.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	d.closers = append(d.closers, target)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	snapshot, ok := source.(cdc.IMilvusSnapshotClientInterface)
+	if !ok {
+		return nil, fmt.Errorf("bootstrap.source does not support snapshots")
+	}
+
+	opts := append(d.options(), d.config.Bootstrap.Target.options(0)...)
+
+	broker, err := cdc.NewBootstrap(snapshot, target, redisCli, d.config.Bootstrap.Channel, opts...).Run(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("bootstrap is failed with err %v", err)
+	}
+
+	return broker, nil
+}
+
+func (d *daemon) workerOptions() []cdc.WorkerOption {
+	var workerOpts []cdc.WorkerOption
+	if d.config.Metrics != nil {
+		d.metrics = cdc.NewMetrics()
+		workerOpts = append(workerOpts, cdc.WithMetricsServer(d.config.Metrics.Listen, d.metrics))
+	}
+
+	if d.config.Health != nil {
+		healthOpts := []cdc.HealthOption{
+			cdc.WithDownThreshold(d.config.Health.DownThreshold),
+			cdc.WithHealthInterval(d.config.Health.Interval),
+		}
+
+		if len(d.config.Health.RequiredTargets) > 0 {
+			healthOpts = append(healthOpts, cdc.WithRequiredTargets(d.config.Health.RequiredTargets...))
+		}
+
+		workerOpts = append(workerOpts, cdc.WithHealthServer(d.config.Health.Listen, healthOpts...))
+	}
+
+	return workerOpts
+}
+
+// targets connects to every milvus target, each broker gets its own clients
+func (d *daemon) targets() ([]cdc.IMilvusClientInterface, error) {
+	milvus := make([]cdc.IMilvusClientInterface, 0, len(d.config.Targets))
+	for i, target := range d.config.Targets {
+		conn, err := d.connect(fmt.Sprintf("targets[%d]", i), target)
+		if err != nil {
+			return nil, err
+		}
+
+		d.closers = append(d.closers, conn)
+		milvus = append(milvus, conn)
+	}
+
+	return milvus, nil
+}
+
+func (d *daemon) connect(path string, target TargetConfig) (cdc.IMilvusClientInterface, error) {
+	opts := []cdc.MilvusOption{cdc.WithMilvusMetrics(d.metrics)}
+	if target.TLS != nil {
+		tlsConfig, err := target.TLS.load()
+		if err != nil {
+			return nil, fmt.Errorf("load tls of %s is failed with err %v", path, err)
+		}
+
+		opts = append(opts, cdc.WithTLS(tlsConfig))
+	}
+
+	var (
+		conn cdc.IMilvusClientInterface
+		err  error
+	)
+
+	switch target.Version {
+	case MilvusV2:
+		conn, err = cdc.NewMilvusV2Client(target.Host, target.Port, target.Timeout, opts...)
+	default:
+		conn, err = cdc.NewMilvusClient(target.Host, target.Port, target.Timeout, opts...)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("connect %s %v:%v is failed with err %v", path, target.Host, target.Port, err)
+	}
+
+	return conn, nil
+}
+
+func (d *daemon) redis() (*redis.Client, error) {
+	redisOpts, err := redis.ParseURL(d.config.Redis.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse redis.url is failed with err %v", err)
+	}
+
+	redisCli := redis.NewClient(redisOpts)
+	d.closers = append(d.closers, redisCli)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cdc.DefaultTimeout)
+	defer cancel()
+
+	err = redisCli.Ping(ctx).Err()
+	if err != nil {
+		return nil, fmt.Errorf("connect redis is failed with err %v", err)
+	}
+
+	return redisCli, nil
+}
+
+func (d *daemon) options() []cdc.Option {
+	opts := []cdc.Option{
+		cdc.WithConsumerGroup(d.config.Consumer.Group, d.config.Consumer.Name),
+		cdc.WithBatching(d.config.Batching.Size, d.config.Batching.Latency),
+		cdc.WithMetrics(d.metrics),
+	}
+
+	if d.config.Metrics != nil {
+		opts = append(opts, cdc.WithMetricsInterval(d.config.Metrics.Interval))
+	}
+
+	if d.config.Retry != nil {
+		// zero values keep the defaults of the policy
+		policy := cdc.DefaultRetryPolicy()
+		policy.MaxAttempts = d.config.Retry.MaxAttempts
+		policy.Multiplier = d.config.Retry.Multiplier
+		if d.config.Retry.InitialBackoff > 0 {
+			policy.InitialBackoff = d.config.Retry.InitialBackoff
+		}
+
+		if d.config.Retry.MaxBackoff > 0 {
+			policy.MaxBackoff = d.config.Retry.MaxBackoff
+		}
+
+		if d.config.Retry.Jitter > 0 {
+			policy.Jitter = d.config.Retry.Jitter
+		}
+
+		opts = append(opts, cdc.WithRetryPolicy(policy))
+	}
+
+	return opts
+}
+
+func (d *daemon) brokers() ([]cdc.BrokerFactoryOption, error) {
+	var (
+		opts    = d.options()
+		brokers []cdc.BrokerFactoryOption
+	)
+
+	if d.eventLog != nil {
+		opts = append(opts, cdc.WithEventLog(d.eventLog))
+	}
+
+	for i, target := range d.config.Targets {
+		opts = append(opts, target.options(i)...)
+	}
+
+	if d.config.Redis != nil {
+		redisCli, err := d.redis()
+		if err != nil {
+			return nil, err
+		}
+
+		milvus, err := d.targets()
+		if err != nil {
+			return nil, err
+		}
+
+		d.names = append(d.names, cdc.Redis)
+		brokers = append(brokers, cdc.WithRedisBroker(cdc.NewRedisBroker(redisCli, milvus, opts...)))
+	}
+
+	if d.config.Kafka != nil {
+		milvus, err := d.targets()
+		if err != nil {
+			return nil, err
+		}
+
+		d.names = append(d.names, cdc.Kafka)
+		brokers = append(brokers, cdc.WithKafkaBroker(cdc.NewKafkaBroker(d.config.Kafka.Brokers, milvus, opts...)))
+	}
+
+	if d.config.Nats != nil {
+		conn, err := nats.Connect(d.config.Nats.URL)
+		if err != nil {
+			return nil, fmt.Errorf("connect nats is failed with err %v", err)
+		}
+
+		d.closers = append(d.closers, closerFunc(func() error {
+			conn.Close()
+			return nil
+		}))
+
+		milvus, err := d.targets()
+		if err != nil {
+			return nil, err
+		}
+
+		d.names = append(d.names, cdc.Nats)
+		brokers = append(brokers, cdc.WithNatsBroker(cdc.NewNatsBroker(conn, milvus, opts...)))
+	}
+
+	if d.config.RabbitMQ != nil {
+		conn, err := amqp.Dial(d.config.RabbitMQ.URL)
+		if err != nil {
+			return nil, fmt.Errorf("connect rabbitmq is failed with err %v", err)
+		}
+
+		d.closers = append(d.closers, conn)
+
+		milvus, err := d.targets()
+		if err != nil {
+			return nil, err
+		}
+
+		d.names = append(d.names, cdc.RabbitMQ)
+		brokers = append(brokers, cdc.WithRabbitMQBroker(cdc.NewRabbitMQBroker(conn, milvus, opts...)))
+	}
+
+	return brokers, nil
+}
+
+// run starts every subscription and blocks until one of them fails or SIGINT or SIGTERM stops them, the brokers are
+// then stopped gracefully within the shutdown timeout
+func (d *daemon) run() error {
+	defer d.closeEventLog()
+	// the brokers leave the connections open once they stop
+	defer d.close()
+
+	subscriptions := make([]cdc.Subscription, 0, len(d.config.Subscriptions))
+	for _, subscription := range d.config.Subscriptions {
+		subscriptions = append(subscriptions, cdc.Subscription{
+			Broker:  subscription.Broker,
+			Channel: subscription.Channel,
+			Pattern: subscription.Pattern,
+		})
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- d.worker.StartAll(subscriptions...)
+	}()
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sig)
+
+	select {
+	case err := <-done:
+		// every subscription already returned, stopping only releases the connections
+		ctx, cancel := context.WithTimeout(context.Background(), d.config.ShutdownTimeout)
+		defer cancel()
+
+		return errors.Join(err, d.stop(ctx))
+	case s := <-sig:
+		logrus.Infof("received %v, stop the brokers within %v", s, d.config.ShutdownTimeout)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), d.config.ShutdownTimeout)
+	defer cancel()
+
+	errStop := d.stop(ctx)
+
+	select {
+	case err := <-done:
+		return errors.Join(err, errStop)
+	case <-ctx.Done():
+		return errors.Join(errStop, fmt.Errorf("the subscriptions did not stop within %v", d.config.ShutdownTimeout))
+	}
+}
+
+// stop stops every configured broker concurrently, so they share the shutdown deadline
+func (d *daemon) stop(ctx context.Context) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	for _, broker := range d.names {
+		wg.Add(1)
+		go func(broker string) {
+			defer wg.Done()
+			err := d.worker.Stop(ctx, broker)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("stop broker %v is failed with err %w", broker, err))
+				mu.Unlock()
+			}
+		}(broker)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// close releases the connections opened while the daemon is built
+func (d *daemon) close() {
+	for _, closer := range d.closers {
+		err := closer.Close()
+		if err != nil {
+			logrus.Errorf("close connection is failed with err %v", err)
+		}
+	}
+}
+
+func (d *daemon) closeEventLog() {
+	if d.eventLog == nil {
+		return
+	}
+
+	err := d.eventLog.Close()
+	if err != nil {
+		logrus.Errorf("close event log is failed with err %v", err)
+	}
+}
+
+type closerFunc func() error
+
+func (f closerFunc) Close() error {
+	return f()
+}
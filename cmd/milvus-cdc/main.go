@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+)
+
+func main() {
+	// milvus-cdc bootstrap -config file copies a source into a new target before streaming to it, milvus-cdc check
+	// -config file reports how the targets drifted from the source and milvus-cdc replay -config file applies the
+	// event log to a target
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "bootstrap":
+			bootstrap(os.Args[2:])
+			return
+		case "check":
+			check(os.Args[2:])
+			return
+		case "replay":
+			replay(os.Args[2:])
+			return
+		}
+	}
+
+	path := flag.String("config", "milvus-cdc.yaml", "path of the YAML or JSON config file")
+	flag.Parse()
+
+	config, err := LoadConfig(*path)
+	if err != nil {
+		// printed as is so every validation error stays on its own line
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	d, err := newDaemon(config)
+	if err != nil {
+		logrus.Fatalf("start milvus-cdc is failed with err %v", err)
+	}
+
+	err = d.run()
+	if err != nil {
+		logrus.Fatalf("milvus-cdc is stopped with err %v", err)
+	}
+
+	logrus.Info("milvus-cdc is stopped")
+}
+
+func bootstrap(args []string) {
+	flags := flag.NewFlagSet("bootstrap", flag.ExitOnError)
+	path := flags.String("config", "milvus-cdc.yaml", "path of the YAML or JSON config file")
+	_ = flags.Parse(args)
+
+	config, err := LoadBootstrapConfig(*path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	d, err := newBootstrapDaemon(config)
+	if err != nil {
+		logrus.Fatalf("bootstrap milvus-cdc is failed with err %v", err)
+	}
+
+	err = d.run()
+	if err != nil {
+		logrus.Fatalf("milvus-cdc is stopped with err %v", err)
+	}
+
+	logrus.Info("milvus-cdc is stopped")
+}
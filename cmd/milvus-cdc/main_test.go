@@ -0,0 +1,40 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestBinaryStarts builds the daemon and runs it, the Milvus 1.x and 2.x SDKs are both linked in so a protobuf file
+// registered twice would panic before main
+func TestBinaryStarts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building the binary is skipped in short mode")
+	}
+
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "milvus-cdc")
+
+	output, err := exec.Command("go", "build", "-o", binary, ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("build milvus-cdc is failed with err %v:\n%s", err, output)
+	}
+
+	// a conflict panics whatever the environment of the test says
+	run := exec.Command(binary, "-config", filepath.Join(dir, "missing.yaml"))
+	run.Env = append(os.Environ(), "GOLANG_PROTOBUF_REGISTRATION_CONFLICT=panic")
+	output, err = run.CombinedOutput()
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
+		t.Fatalf("milvus-cdc exits with err %v:\n%s", err, output)
+	}
+
+	if strings.Contains(string(output), "panic") || !strings.Contains(string(output), "no such file or directory") {
+		t.Fatalf("milvus-cdc does not report the missing config:\n%s", output)
+	}
+}
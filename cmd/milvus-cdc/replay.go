@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	cdc "github.com/warriors-vn/milvus-cdc"
+)
+
+// replay applies the entries of the event log between two positions or two timestamps to one of the targets
+func replay(args []string) {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	path := flags.String("config", "milvus-cdc.yaml", "path of the YAML or JSON config file")
+	target := flags.Int("target", 0, "index of the target the entries are applied to")
+	from := flags.Int64("from", 1, "position of the first entry to replay")
+	to := flags.Int64("to", 0, "position of the last entry to replay, 0 replays up to the end of the log")
+	since := flags.String("since", "", "RFC 3339 time of the first entry to replay, the times take precedence over the positions")
+	until := flags.String("until", "", "RFC 3339 time of the last entry to replay, the times take precedence over the positions")
+	_ = flags.Parse(args)
+
+	config, err := LoadReplayConfig(*path)
+	if err == nil && (*target < 0 || *target >= len(config.Targets)) {
+		err = fmt.Errorf("-target %d is not the index of a target", *target)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var sinceTime, untilTime time.Time
+	for _, flagTime := range []struct {
+		name  string
+		value string
+		time  *time.Time
+	}{{"since", *since, &sinceTime}, {"until", *until, &untilTime}} {
+		if flagTime.value == "" {
+			continue
+		}
+
+		*flagTime.time, err = time.Parse(time.RFC3339Nano, flagTime.value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "-%s %q is not an RFC 3339 time\n", flagTime.name, flagTime.value)
+			os.Exit(1)
+		}
+	}
+
+	d := &daemon{
+		config: config,
+	}
+
+	applied, err := d.replay(*target, *from, *to, sinceTime, untilTime)
+	d.close()
+
+	if err != nil {
+		logrus.Fatalf("replay is failed after %d entries with err %v", applied, err)
+	}
+
+	logrus.Infof("%d entries are replayed to targets[%d]", applied, *target)
+}
+
+func (d *daemon) replay(target int, from, to int64, since, until time.Time) (int, error) {
+	eventLog, err := cdc.NewFileEventLog(d.config.EventLog.Dir)
+	if err != nil {
+		return 0, fmt.Errorf("open event_log.dir is failed with err %v", err)
+	}
+
+	d.closers = append(d.closers, eventLog)
+
+	conn, err := d.connect(fmt.Sprintf("targets[%d]", target), d.config.Targets[target])
+	if err != nil {
+		return 0, err
+	}
+
+	d.closers = append(d.closers, conn)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// the replayer applies to a single target
+	opts := append(d.options(), d.config.Targets[target].options(0)...)
+
+	replayer := cdc.NewReplayer(eventLog, conn, opts...)
+	if !since.IsZero() || !until.IsZero() {
+		return replayer.ReplayBetween(ctx, since, until)
+	}
+
+	return replayer.Replay(ctx, from, to)
+}
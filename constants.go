@@ -3,25 +3,129 @@ package milvus_cdc
 import "time"
 
 const (
-	Redis = "redis"
+	Redis    = "redis"
+	Kafka    = "kafka"
+	Nats     = "nats"
+	RabbitMQ = "rabbitmq"
 )
 
 const (
-	Insert           = "insert"
-	Delete           = "delete"
-	CreateCollection = "create-collection"
-	DropCollection   = "drop-collection"
-	CreatePartition  = "create-partition"
-	DropPartition    = "drop-partition"
-	CreateIndex      = "create-index"
-	DropIndex        = "drop-index"
+	Insert            = "insert"
+	Delete            = "delete"
+	CreateCollection  = "create-collection"
+	DropCollection    = "drop-collection"
+	CreatePartition   = "create-partition"
+	DropPartition     = "drop-partition"
+	CreateIndex       = "create-index"
+	DropIndex         = "drop-index"
+	LoadCollection    = "load-collection"
+	ReleaseCollection = "release-collection"
+)
+
+const (
+	Bool    = "bool"
+	Int8    = "int8"
+	Int16   = "int16"
+	Int32   = "int32"
+	Int64   = "int64"
+	Float   = "float"
+	Double  = "double"
+	VarChar = "varchar"
+	JSON    = "json"
 )
 
 const (
 	PubSub = "pub-sub"
 	Queue  = "queue"
+	Stream = "stream"
 )
 
 const (
 	DefaultTimeout = 10 * time.Second
 )
+
+const (
+	DefaultPrimaryField   = "id"
+	DefaultVectorField    = "vector"
+	DefaultShardsNum      = 1
+	DefaultMaxLength      = 65535
+	DefaultHNSWM          = 16
+	DefaultEfConstruction = 200
+	MetricTypeProperty    = "milvus_cdc.metric_type"
+)
+
+const (
+	StreamPayloadField       = "message"
+	DefaultConsumerGroup     = "milvus-cdc"
+	DefaultClaimMinIdle      = time.Minute
+	DefaultReadCount         = 10
+	DefaultReadBlock         = 5 * time.Second
+	DefaultRetryDelay        = time.Second
+	DefaultMaxDeliver        = 5
+	DefaultPrefetch          = 10
+	DeadLetterSuffix         = ".dead-letter"
+	DefaultBatchSize         = 1
+	DefaultBatchLatency      = 10 * time.Millisecond
+	DefaultDeadLetterKey     = "milvus-cdc:dead-letter"
+	DefaultCheckpointKey     = "milvus-cdc:checkpoint"
+	DefaultSequenceKey       = "milvus-cdc:sequence"
+	DefaultPartitions        = 8
+	DefaultPartitionTag      = "_default"
+	DefaultSnapshotBatchSize = 1000
+)
+
+const (
+	TracerName        = "github.com/warriors-vn/milvus-cdc"
+	TraceParentHeader = "traceparent"
+	TraceStateHeader  = "tracestate"
+)
+
+const (
+	MetricsNamespace       = "milvus_cdc"
+	DefaultMetricsInterval = 15 * time.Second
+)
+
+const (
+	DefaultHealthInterval = 10 * time.Second
+	DefaultDownThreshold  = 30 * time.Second
+)
+
+const (
+	DefaultMaxLag           = 10000
+	DefaultMaxQueueLength   = 1000000
+	DefaultCatchUpBatchSize = 100
+)
+
+const (
+	DefaultMaxAttempts    = 1
+	DefaultInitialBackoff = 100 * time.Millisecond
+	DefaultMaxBackoff     = 10 * time.Second
+	DefaultMultiplier     = 2
+	DefaultJitter         = 0.2
+)
+
+const (
+	DriftMissingCollection = "missing-collection"
+	DriftExtraCollection   = "extra-collection"
+	DriftCollectionParams  = "collection-params"
+	DriftMissingPartition  = "missing-partition"
+	DriftExtraPartition    = "extra-partition"
+	DriftIndex             = "index"
+	DriftRowCount          = "row-count"
+	DriftMissingEntities   = "missing-entities"
+	DriftExtraEntities     = "extra-entities"
+	DriftVectors           = "vectors"
+)
+
+const (
+	DefaultSampleRate     = 1
+	DefaultCheckBatchSize = 1000
+	DefaultCheckSettle    = 5 * time.Second
+)
+
+const (
+	DefaultSegmentSize     = 64 << 20
+	DefaultReplayReadCount = 100
+	// DefaultRecordedKeys is how many event ids and sequences are remembered to record a redelivered message once
+	DefaultRecordedKeys = 100000
+)
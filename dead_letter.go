@@ -0,0 +1,12 @@
+package milvus_cdc
+
+import "time"
+
+type DeadLetter struct {
+	Id        string    `json:"id"`
+	Payload   string    `json:"payload"`
+	Target    int       `json:"target"`
+	Error     string    `json:"error"`
+	Attempts  int       `json:"attempts"`
+	Timestamp time.Time `json:"timestamp"`
+}
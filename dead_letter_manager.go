@@ -0,0 +1,56 @@
+package milvus_cdc
+
+import (
+	"context"
+	"time"
+)
+
+type DeadLetterManager struct {
+	sink   IDeadLetterSink
+	syncer *syncer
+}
+
+func NewDeadLetterManager(sink IDeadLetterSink, milvus []IMilvusClientInterface, opts ...Option) *DeadLetterManager {
+	return &DeadLetterManager{
+		sink:   sink,
+		syncer: newSyncer(milvus, newOptions(opts...)),
+	}
+}
+
+func (dm *DeadLetterManager) List(ctx context.Context, start string, count int64) ([]*DeadLetter, error) {
+	return dm.sink.List(ctx, start, count)
+}
+
+func (dm *DeadLetterManager) Get(ctx context.Context, id string) (*DeadLetter, error) {
+	return dm.sink.Get(ctx, id)
+}
+
+// Replay applies the dead letter again to its target and removes it once applied, on failure the letter is
+// stored again with one more attempt
+func (dm *DeadLetterManager) Replay(ctx context.Context, id string) error {
+	letter, err := dm.sink.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	errHandle := dm.syncer.handle(ctx, letter.Payload, letter.Target)
+	if errHandle != nil {
+		_, err = dm.sink.Put(ctx, &DeadLetter{
+			Payload:   letter.Payload,
+			Target:    letter.Target,
+			Error:     errHandle.Error(),
+			Attempts:  letter.Attempts + 1,
+			Timestamp: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = dm.sink.Delete(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return errHandle
+}
@@ -0,0 +1,169 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus/grpc/gen"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ErrorClass string
+
+const (
+	ErrorClassTransient ErrorClass = "transient"
+	ErrorClassPermanent ErrorClass = "permanent"
+)
+
+// transientReasons are the messages of the failed statuses that may succeed later, Milvus reports most of its failures
+// as an unexpected error and only the message tells a transient cause from a schema or argument error
+var transientReasons = []string{
+	"service not ready",
+	"service unavailable",
+	"server is busy",
+	"request limit exceeded",
+	"rate limit exceeded",
+	"not fully loaded",
+	"deadline exceeded",
+	"timeout",
+	"connection refused",
+	"connection reset",
+}
+
+// errSkipped fails the messages of a batch that follow a transient failure, applying them first would reorder them
+var errSkipped = &MilvusError{
+	Op:    "sync",
+	Class: ErrorClassTransient,
+	Err:   errors.New("an earlier message of the batch is not applied"),
+}
+
+type MilvusError struct {
+	Op      string
+	Code    int64
+	Message string
+	Class   ErrorClass
+	Err     error
+}
+
+func (e *MilvusError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%v is failed with %v error: %v", e.Op, e.Class, e.Err)
+	}
+
+	return fmt.Sprintf("%v is failed with %v error code %d: %v", e.Op, e.Class, e.Code, e.Message)
+}
+
+func (e *MilvusError) Unwrap() error {
+	return e.Err
+}
+
+func (e *MilvusError) Retryable() bool {
+	return e.Class == ErrorClassTransient
+}
+
+type RetryError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("gave up after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// ClassifyError tells whether retrying the error may succeed, errors not coming from a milvus client are classified by
+// their gRPC status and unknown errors are permanent
+func ClassifyError(err error) ErrorClass {
+	var milvusErr *MilvusError
+	if errors.As(err, &milvusErr) {
+		return milvusErr.Class
+	}
+
+	return classifyRPC(err)
+}
+
+func IsRetryable(err error) bool {
+	return ClassifyError(err) == ErrorClassTransient
+}
+
+// isAlreadyExists reports whether a create failed because the collection or partition exists, Milvus 1.x reports it
+// with a message and no code of its own
+func isAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return status.Code(errors.Unwrap(err)) == codes.AlreadyExists || strings.Contains(strings.ToLower(err.Error()), "already exist")
+}
+
+func newRPCError(op string, err error) error {
+	return &MilvusError{
+		Op:    op,
+		Class: classifyRPC(err),
+		Err:   err,
+	}
+}
+
+func newStatusError(op string, s milvus.Status) error {
+	code := s.GetStatus().ErrorCode
+	class := ErrorClassPermanent
+	switch gen.ErrorCode(code) {
+	case gen.ErrorCode_CONNECT_FAILED, gen.ErrorCode_META_FAILED, gen.ErrorCode_CACHE_FAILED, gen.ErrorCode_OUT_OF_MEMORY:
+		class = ErrorClassTransient
+	case gen.ErrorCode_UNEXPECTED_ERROR:
+		class = classifyReason(s.GetMessage())
+	}
+
+	return &MilvusError{
+		Op:      op,
+		Code:    code,
+		Message: s.GetMessage(),
+		Class:   class,
+	}
+}
+
+func newInvalidError(op string, err error) error {
+	return &MilvusError{
+		Op:    op,
+		Class: ErrorClassPermanent,
+		Err:   err,
+	}
+}
+
+func classifyRPC(err error) ErrorClass {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTransient
+	}
+
+	s, ok := status.FromError(err)
+	if !ok {
+		return ErrorClassPermanent
+	}
+
+	switch s.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.AlreadyExists, codes.PermissionDenied,
+		codes.FailedPrecondition, codes.OutOfRange, codes.Unimplemented, codes.Unauthenticated:
+		return ErrorClassPermanent
+	}
+
+	return ErrorClassTransient
+}
+
+// classifyReason tells a transient failure by its message, any other failure is permanent
+func classifyReason(reason string) ErrorClass {
+	reason = strings.ToLower(reason)
+	for _, transient := range transientReasons {
+		if strings.Contains(reason, transient) {
+			return ErrorClassTransient
+		}
+	}
+
+	return ErrorClassPermanent
+}
@@ -0,0 +1,12 @@
+package milvus_cdc
+
+import "time"
+
+// LogEntry is a message recorded in the event log, Position is assigned by the log and grows by one per entry
+type LogEntry struct {
+	Position  int64     `json:"position"`
+	EventId   string    `json:"event_id,omitempty"`
+	Sequence  int64     `json:"sequence,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+	Payload   string    `json:"payload"`
+}
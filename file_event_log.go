@@ -0,0 +1,507 @@
+package milvus_cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	segmentLogExt   = ".log"
+	segmentIndexExt = ".idx"
+	// indexRecordSize is the size of the position, timestamp, offset and length of an entry in the index
+	indexRecordSize = 32
+)
+
+type FileEventLogOption func(*FileEventLog)
+
+// WithSegmentSize starts a new segment once the current one holds size bytes
+func WithSegmentSize(size int64) FileEventLogOption {
+	return func(l *FileEventLog) {
+		if size > 0 {
+			l.segmentSize = size
+		}
+	}
+}
+
+// WithFsync flushes every entry to the disk before Append returns, otherwise the entries survive a crash of the
+// process but not of the host
+func WithFsync(fsync bool) FileEventLogOption {
+	return func(l *FileEventLog) {
+		l.fsync = fsync
+	}
+}
+
+// FileEventLog keeps the event log in a directory. The entries are JSON lines in segment files named after the
+// position of their first entry, and every segment has an index holding the position, timestamp, offset and length
+// of its entries, so positions and timestamps are found without reading the segments
+type FileEventLog struct {
+	mu          sync.Mutex
+	dir         string
+	segmentSize int64
+	fsync       bool
+	segments    []logSegment
+	log         *os.File
+	index       *os.File
+	lastTime    int64
+	closed      bool
+}
+
+type logSegment struct {
+	first     int64
+	count     int64
+	size      int64
+	firstTime int64
+	lastTime  int64
+}
+
+type indexRecord struct {
+	position  int64
+	timestamp int64
+	offset    int64
+	length    int64
+}
+
+// NewFileEventLog opens the log kept in dir and creates it when needed. An entry the process was writing when it
+// stopped is dropped so the log only holds complete entries
+func NewFileEventLog(dir string, opts ...FileEventLogOption) (*FileEventLog, error) {
+	l := &FileEventLog{
+		dir:         dir,
+		segmentSize: DefaultSegmentSize,
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.load()
+	if err != nil {
+		return nil, fmt.Errorf("load event log %v is failed with err %v", dir, err)
+	}
+
+	if len(l.segments) == 0 {
+		err = l.rotate(1)
+	} else {
+		err = l.openActive()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// load reads the index of every segment and repairs the last one, the only one written to
+func (l *FileEventLog) load() error {
+	files, err := os.ReadDir(l.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		name := file.Name()
+		if !strings.HasSuffix(name, segmentIndexExt) {
+			continue
+		}
+
+		first, err := strconv.ParseInt(strings.TrimSuffix(name, segmentIndexExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		segment, err := l.loadSegment(first)
+		if err != nil {
+			return err
+		}
+
+		l.segments = append(l.segments, segment)
+	}
+
+	sort.Slice(l.segments, func(i, j int) bool {
+		return l.segments[i].first < l.segments[j].first
+	})
+
+	if len(l.segments) == 0 {
+		return nil
+	}
+
+	last := &l.segments[len(l.segments)-1]
+
+	err = os.Truncate(l.path(last.first, segmentIndexExt), last.count*indexRecordSize)
+	if err != nil {
+		return err
+	}
+
+	err = os.Truncate(l.path(last.first, segmentLogExt), last.size)
+	if err != nil {
+		return err
+	}
+
+	l.lastTime = last.lastTime
+
+	return nil
+}
+
+func (l *FileEventLog) loadSegment(first int64) (logSegment, error) {
+	segment := logSegment{first: first}
+
+	index, err := os.Open(l.path(first, segmentIndexExt))
+	if err != nil {
+		return segment, err
+	}
+	defer index.Close()
+
+	info, err := index.Stat()
+	if err != nil {
+		return segment, err
+	}
+
+	// a partly written record is ignored
+	segment.count = info.Size() / indexRecordSize
+	if segment.count == 0 {
+		return segment, nil
+	}
+
+	records, err := readIndex(index, 0, 1)
+	if err != nil {
+		return segment, err
+	}
+
+	segment.firstTime = records[0].timestamp
+
+	records, err = readIndex(index, segment.count-1, 1)
+	if err != nil {
+		return segment, err
+	}
+
+	segment.lastTime = records[0].timestamp
+	segment.size = records[0].offset + records[0].length
+
+	return segment, nil
+}
+
+func (l *FileEventLog) openActive() error {
+	first := l.segments[len(l.segments)-1].first
+
+	var err error
+
+	l.log, err = os.OpenFile(l.path(first, segmentLogExt), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+
+	l.index, err = os.OpenFile(l.path(first, segmentIndexExt), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return errors.Join(err, l.log.Close())
+	}
+
+	return nil
+}
+
+// rotate closes the active segment and starts a new one whose first entry gets position first
+func (l *FileEventLog) rotate(first int64) error {
+	err := l.closeActive()
+	if err != nil {
+		return err
+	}
+
+	l.segments = append(l.segments, logSegment{first: first})
+
+	return l.openActive()
+}
+
+func (l *FileEventLog) closeActive() error {
+	if l.log == nil {
+		return nil
+	}
+
+	return errors.Join(l.log.Sync(), l.index.Sync(), l.log.Close(), l.index.Close())
+}
+
+// Append records the entry, its timestamp is moved forward when it is older than the last one so the timestamps of
+// the log never go back
+func (l *FileEventLog) Append(_ context.Context, entry *LogEntry) (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return 0, fmt.Errorf("the event log is closed")
+	}
+
+	active := &l.segments[len(l.segments)-1]
+	position := active.first + active.count
+
+	timestamp := entry.Timestamp.UnixNano()
+	if entry.Timestamp.IsZero() {
+		timestamp = time.Now().UnixNano()
+	}
+
+	timestamp = max(timestamp, l.lastTime)
+
+	entry.Position = position
+	entry.Timestamp = time.Unix(0, timestamp).UTC()
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return 0, err
+	}
+
+	data = append(data, '\n')
+
+	if active.count > 0 && active.size+int64(len(data)) > l.segmentSize {
+		err = l.rotate(position)
+		if err != nil {
+			return 0, fmt.Errorf("rotate the event log is failed with err %v", err)
+		}
+
+		active = &l.segments[len(l.segments)-1]
+	}
+
+	record := indexRecord{
+		position:  position,
+		timestamp: timestamp,
+		offset:    active.size,
+		length:    int64(len(data)),
+	}
+
+	err = l.write(active, data, record)
+	if err != nil {
+		return 0, err
+	}
+
+	if active.count == 0 {
+		active.firstTime = timestamp
+	}
+
+	active.count++
+	active.size += record.length
+	active.lastTime = timestamp
+	l.lastTime = timestamp
+
+	return position, nil
+}
+
+// write appends the entry and then its index record, the segment is cut back to where it was if either fails
+func (l *FileEventLog) write(active *logSegment, data []byte, record indexRecord) error {
+	_, err := l.log.Write(data)
+	if err == nil {
+		_, err = l.index.Write(record.encode())
+	}
+
+	if err == nil && l.fsync {
+		err = errors.Join(l.log.Sync(), l.index.Sync())
+	}
+
+	if err != nil {
+		return errors.Join(
+			fmt.Errorf("write the event log is failed with err %v", err),
+			l.log.Truncate(active.size),
+			l.index.Truncate(active.count*indexRecordSize),
+		)
+	}
+
+	return nil
+}
+
+func (l *FileEventLog) Read(ctx context.Context, from int64, count int) ([]*LogEntry, error) {
+	segments := l.snapshot()
+
+	from = max(from, 1)
+
+	var entries []*LogEntry
+	for len(entries) < count {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		i := sort.Search(len(segments), func(i int) bool {
+			return segments[i].first > from
+		}) - 1
+
+		if i < 0 || from >= segments[i].first+segments[i].count {
+			break
+		}
+
+		n := min(int64(count-len(entries)), segments[i].first+segments[i].count-from)
+
+		read, err := l.readSegment(segments[i], from-segments[i].first, n)
+		if err != nil {
+			return nil, fmt.Errorf("read the event log from position %d is failed with err %v", from, err)
+		}
+
+		entries = append(entries, read...)
+		from += n
+	}
+
+	return entries, nil
+}
+
+// readSegment reads n entries of the segment starting with its i-th one
+func (l *FileEventLog) readSegment(segment logSegment, i, n int64) ([]*LogEntry, error) {
+	index, err := os.Open(l.path(segment.first, segmentIndexExt))
+	if err != nil {
+		return nil, err
+	}
+	defer index.Close()
+
+	records, err := readIndex(index, i, n)
+	if err != nil {
+		return nil, err
+	}
+
+	log, err := os.Open(l.path(segment.first, segmentLogExt))
+	if err != nil {
+		return nil, err
+	}
+	defer log.Close()
+
+	start := records[0].offset
+	end := records[len(records)-1].offset + records[len(records)-1].length
+
+	data := make([]byte, end-start)
+
+	_, err = log.ReadAt(data, start)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*LogEntry, 0, len(records))
+	for _, record := range records {
+		var entry LogEntry
+
+		line := bytes.TrimSuffix(data[record.offset-start:record.offset-start+record.length], []byte("\n"))
+
+		err = json.Unmarshal(line, &entry)
+		if err != nil {
+			return nil, fmt.Errorf("the entry at position %d is invalid: %v", record.position, err)
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+func (l *FileEventLog) Seek(ctx context.Context, timestamp time.Time) (int64, error) {
+	segments := l.snapshot()
+	ts := timestamp.UnixNano()
+
+	for _, segment := range segments {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		if segment.count == 0 || segment.lastTime < ts {
+			continue
+		}
+
+		if segment.firstTime >= ts {
+			return segment.first, nil
+		}
+
+		return l.seekSegment(segment, ts)
+	}
+
+	last := segments[len(segments)-1]
+
+	return last.first + last.count, nil
+}
+
+// seekSegment searches the index of a segment holding timestamp for the first entry recorded at or after it
+func (l *FileEventLog) seekSegment(segment logSegment, ts int64) (int64, error) {
+	index, err := os.Open(l.path(segment.first, segmentIndexExt))
+	if err != nil {
+		return 0, err
+	}
+	defer index.Close()
+
+	var errRead error
+	i := sort.Search(int(segment.count), func(i int) bool {
+		records, err := readIndex(index, int64(i), 1)
+		if err != nil {
+			errRead = err
+			return true
+		}
+
+		return records[0].timestamp >= ts
+	})
+
+	if errRead != nil {
+		return 0, fmt.Errorf("seek the event log is failed with err %v", errRead)
+	}
+
+	return segment.first + int64(i), nil
+}
+
+// snapshot copies the segments so they are read without holding the lock, the entries they count are complete
+func (l *FileEventLog) snapshot() []logSegment {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]logSegment(nil), l.segments...)
+}
+
+func (l *FileEventLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.closed {
+		return nil
+	}
+
+	l.closed = true
+
+	return l.closeActive()
+}
+
+func (l *FileEventLog) path(first int64, ext string) string {
+	return filepath.Join(l.dir, fmt.Sprintf("%020d%s", first, ext))
+}
+
+func (r indexRecord) encode() []byte {
+	b := make([]byte, indexRecordSize)
+	binary.BigEndian.PutUint64(b[0:], uint64(r.position))
+	binary.BigEndian.PutUint64(b[8:], uint64(r.timestamp))
+	binary.BigEndian.PutUint64(b[16:], uint64(r.offset))
+	binary.BigEndian.PutUint64(b[24:], uint64(r.length))
+
+	return b
+}
+
+// readIndex reads n records of the index starting with the i-th one
+func readIndex(index *os.File, i, n int64) ([]indexRecord, error) {
+	b := make([]byte, n*indexRecordSize)
+
+	_, err := index.ReadAt(b, i*indexRecordSize)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]indexRecord, 0, n)
+	for off := int64(0); off < int64(len(b)); off += indexRecordSize {
+		records = append(records, indexRecord{
+			position:  int64(binary.BigEndian.Uint64(b[off:])),
+			timestamp: int64(binary.BigEndian.Uint64(b[off+8:])),
+			offset:    int64(binary.BigEndian.Uint64(b[off+16:])),
+			length:    int64(binary.BigEndian.Uint64(b[off+24:])),
+		})
+	}
+
+	return records, nil
+}
@@ -0,0 +1,157 @@
+package milvus_cdc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newTestEventLog opens a log in dir that is closed with the test
+func newTestEventLog(t *testing.T, dir string, opts ...FileEventLogOption) *FileEventLog {
+	t.Helper()
+
+	log, err := NewFileEventLog(dir, opts...)
+	if err != nil {
+		t.Fatalf("new file event log is failed with err %v", err)
+	}
+	t.Cleanup(func() {
+		_ = log.Close()
+	})
+
+	return log
+}
+
+// appendEntries appends the entries with the payloads 1 to n, recorded a second apart from start
+func appendEntries(t *testing.T, log IEventLog, start time.Time, n int) {
+	t.Helper()
+
+	for i := 1; i <= n; i++ {
+		_, err := log.Append(context.Background(), &LogEntry{
+			EventId:   "e" + strconv.Itoa(i),
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Payload:   strconv.Itoa(i),
+		})
+		if err != nil {
+			t.Fatalf("append is failed with err %v", err)
+		}
+	}
+}
+
+// payloads reads the payloads of the log from position from on
+func payloads(t *testing.T, log IEventLog, from int64, count int) []string {
+	t.Helper()
+
+	entries, err := log.Read(context.Background(), from, count)
+	if err != nil {
+		t.Fatalf("read is failed with err %v", err)
+	}
+
+	read := make([]string, 0, len(entries))
+	for i, entry := range entries {
+		if entry.Position != from+int64(i) {
+			t.Fatalf("the entry %d has the position %d", i, entry.Position)
+		}
+
+		read = append(read, entry.Payload)
+	}
+
+	return read
+}
+
+// appendFile writes data at the end of a file of the log, as a process stopped in the middle of an append would
+func appendFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		t.Fatalf("open %v is failed with err %v", path, err)
+	}
+	defer file.Close()
+
+	_, err = file.Write(data)
+	if err != nil {
+		t.Fatalf("write %v is failed with err %v", path, err)
+	}
+}
+
+func TestFileEventLogReadsAndSeeksAcrossSegments(t *testing.T) {
+	dir := t.TempDir()
+	log := newTestEventLog(t, dir, WithSegmentSize(200))
+	start := time.Unix(1700000000, 0)
+	appendEntries(t, log, start, 10)
+
+	segments, _ := filepath.Glob(filepath.Join(dir, "*"+segmentLogExt))
+	if len(segments) < 2 {
+		t.Fatalf("the entries are kept in %d segments", len(segments))
+	}
+
+	if read := payloads(t, log, 3, 4); len(read) != 4 || read[0] != "3" || read[3] != "6" {
+		t.Fatalf("the entries 3 to 6 are %v", read)
+	}
+
+	for _, tc := range []struct {
+		timestamp time.Time
+		position  int64
+	}{
+		{start, 1},
+		{start.Add(5 * time.Second), 5},
+		{start.Add(5*time.Second + time.Millisecond), 6},
+		{start.Add(time.Hour), 11},
+	} {
+		position, err := log.Seek(context.Background(), tc.timestamp)
+		if err != nil || position != tc.position {
+			t.Fatalf("seek %v returned %d with err %v, wanted %d", tc.timestamp, position, err, tc.position)
+		}
+	}
+}
+
+func TestFileEventLogDropsAnEntryCutByACrash(t *testing.T) {
+	dir := t.TempDir()
+	log := newTestEventLog(t, dir)
+	appendEntries(t, log, time.Unix(1700000000, 0), 3)
+	_ = log.Close()
+
+	// the entry was written but not its index record, and the next one was cut in the middle of both
+	segment := filepath.Join(dir, "00000000000000000001")
+	appendFile(t, segment+segmentLogExt, []byte(`{"position":4,"payload":"lost"}`+"\n"+`{"posit`))
+	appendFile(t, segment+segmentIndexExt, make([]byte, indexRecordSize/2))
+
+	log = newTestEventLog(t, dir)
+	if read := payloads(t, log, 1, 10); len(read) != 3 || read[2] != "3" {
+		t.Fatalf("the entries after the crash are %v", read)
+	}
+
+	position, err := log.Append(context.Background(), &LogEntry{Payload: "4"})
+	if err != nil || position != 4 {
+		t.Fatalf("append after the crash returned %d with err %v", position, err)
+	}
+
+	if read := payloads(t, log, 1, 10); len(read) != 4 || read[3] != "4" {
+		t.Fatalf("the entries after the crash are %v", read)
+	}
+}
+
+func TestFileEventLogContinuesTheLastSegmentAfterARestart(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Unix(1700000000, 0)
+
+	log := newTestEventLog(t, dir, WithSegmentSize(200))
+	appendEntries(t, log, start, 5)
+	_ = log.Close()
+
+	log = newTestEventLog(t, dir, WithSegmentSize(200))
+
+	// an entry older than the last one is moved forward so Seek stays ordered
+	position, err := log.Append(context.Background(), &LogEntry{Timestamp: start, Payload: "6"})
+	if err != nil || position != 6 {
+		t.Fatalf("append after the restart returned %d with err %v", position, err)
+	}
+
+	entries, err := log.Read(context.Background(), 5, 2)
+	if err != nil || len(entries) != 2 || !entries[1].Timestamp.Equal(entries[0].Timestamp) {
+		t.Fatalf("the last entries are %+v with err %v", entries, err)
+	}
+}
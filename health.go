@@ -0,0 +1,259 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type TargetHealth struct {
+	Target      int        `json:"target"`
+	Connected   bool       `json:"connected"`
+	Status      string     `json:"status,omitempty"`
+	Error       string     `json:"error,omitempty"`
+	LastApplied *time.Time `json:"last_applied,omitempty"`
+	Lag         int64      `json:"lag"`
+	// DownSince is when the target was first seen disconnected, it is reset once the target is connected again
+	DownSince *time.Time `json:"down_since,omitempty"`
+}
+
+type BrokerHealth struct {
+	Broker string `json:"broker"`
+	// Alive reports whether the consumer loops of the broker are still running
+	Alive bool `json:"alive"`
+	// Unknown is set for brokers that do not implement IHealthChecker, their health cannot be told
+	Unknown bool           `json:"unknown,omitempty"`
+	Error   string         `json:"error,omitempty"`
+	Targets []TargetHealth `json:"targets"`
+}
+
+type HealthReport struct {
+	Live      bool           `json:"live"`
+	Ready     bool           `json:"ready"`
+	CheckedAt time.Time      `json:"checked_at"`
+	Brokers   []BrokerHealth `json:"brokers"`
+}
+
+type HealthOption func(*healthServer)
+
+// WithRequiredTargets limits readiness to the given targets, by default every target is required
+func WithRequiredTargets(targets ...int) HealthOption {
+	return func(hs *healthServer) {
+		hs.required = make(map[int]bool, len(targets))
+		for _, target := range targets {
+			hs.required[target] = true
+		}
+	}
+}
+
+// WithDownThreshold sets how long a required target may be down before readiness fails
+func WithDownThreshold(threshold time.Duration) HealthOption {
+	return func(hs *healthServer) {
+		if threshold > 0 {
+			hs.threshold = threshold
+		}
+	}
+}
+
+// WithHealthInterval sets how often the brokers and targets are checked
+func WithHealthInterval(interval time.Duration) HealthOption {
+	return func(hs *healthServer) {
+		if interval > 0 {
+			hs.interval = interval
+		}
+	}
+}
+
+// healthServer checks the running brokers periodically and serves the last report on /healthz for liveness and on
+// /readyz for readiness
+type healthServer struct {
+	server    *http.Server
+	brokers   func() map[string]IBrokerFactory
+	required  map[int]bool
+	threshold time.Duration
+	interval  time.Duration
+	cancel    context.CancelFunc
+	done      chan struct{}
+	mu        sync.RWMutex
+	report    HealthReport
+	downSince map[string]map[int]time.Time
+}
+
+func newHealthServer(addr string, brokers func() map[string]IBrokerFactory, opts ...HealthOption) *healthServer {
+	hs := &healthServer{
+		brokers:   brokers,
+		threshold: DefaultDownThreshold,
+		interval:  DefaultHealthInterval,
+		downSince: make(map[string]map[int]time.Time),
+		// live but not ready until the first check is done
+		report: HealthReport{
+			Live: true,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(hs)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		report := hs.Report()
+		hs.write(w, report, report.Live)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, _ *http.Request) {
+		report := hs.Report()
+		hs.write(w, report, report.Ready)
+	})
+
+	hs.server = &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+
+	return hs
+}
+
+func (hs *healthServer) Report() HealthReport {
+	hs.mu.RLock()
+	defer hs.mu.RUnlock()
+
+	return hs.report
+}
+
+func (hs *healthServer) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	hs.cancel = cancel
+	hs.done = make(chan struct{})
+
+	go func() {
+		defer close(hs.done)
+
+		hs.check(ctx)
+
+		ticker := time.NewTicker(hs.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				hs.check(ctx)
+			}
+		}
+	}()
+
+	go func() {
+		err := hs.server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Errorf("serve health on %v is failed with err %v", hs.server.Addr, err)
+		}
+	}()
+}
+
+func (hs *healthServer) stop(ctx context.Context) error {
+	hs.cancel()
+	<-hs.done
+
+	return hs.server.Shutdown(ctx)
+}
+
+func (hs *healthServer) check(ctx context.Context) {
+	var (
+		now    = time.Now()
+		report = HealthReport{
+			Live:      true,
+			Ready:     true,
+			CheckedAt: now,
+		}
+	)
+
+	for name, broker := range hs.brokers() {
+		checker, ok := broker.(IHealthChecker)
+		if !ok {
+			// a broker that cannot be checked is not known to be ready
+			report.Ready = false
+			report.Brokers = append(report.Brokers, BrokerHealth{
+				Broker:  name,
+				Unknown: true,
+				Error:   "the broker does not report its health",
+			})
+			continue
+		}
+
+		health := hs.checkBroker(ctx, checker)
+		health.Broker = name
+		if !health.Alive {
+			report.Live = false
+			report.Ready = false
+		}
+
+		if health.Error != "" {
+			report.Ready = false
+		}
+
+		for i := range health.Targets {
+			target := &health.Targets[i]
+			target.DownSince = hs.track(name, target.Target, target.Connected, now)
+			if hs.required != nil && !hs.required[target.Target] {
+				continue
+			}
+
+			if target.DownSince != nil && now.Sub(*target.DownSince) > hs.threshold {
+				report.Ready = false
+			}
+		}
+
+		report.Brokers = append(report.Brokers, health)
+	}
+
+	hs.mu.Lock()
+	hs.report = report
+	hs.mu.Unlock()
+}
+
+func (hs *healthServer) checkBroker(ctx context.Context, checker IHealthChecker) BrokerHealth {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	return checker.Health(ctx)
+}
+
+// track returns since when the target of the broker is down, or nil when it is connected
+func (hs *healthServer) track(broker string, target int, connected bool, now time.Time) *time.Time {
+	downSince, ok := hs.downSince[broker]
+	if !ok {
+		downSince = make(map[int]time.Time)
+		hs.downSince[broker] = downSince
+	}
+
+	if connected {
+		delete(downSince, target)
+		return nil
+	}
+
+	since, ok := downSince[target]
+	if !ok {
+		since = now
+		downSince[target] = since
+	}
+
+	return &since
+}
+
+func (hs *healthServer) write(w http.ResponseWriter, report HealthReport, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+
+	err := json.NewEncoder(w).Encode(report)
+	if err != nil {
+		logrus.Errorf("write health report is failed with err %v", err)
+	}
+}
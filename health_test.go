@@ -0,0 +1,89 @@
+package milvus_cdc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// hangingMilvus answers the status checks only once the context it is bound to is done
+type hangingMilvus struct {
+	*fakeMilvus
+
+	ctx context.Context
+}
+
+func (h *hangingMilvus) WithContext(ctx context.Context) IMilvusClientInterface {
+	return &hangingMilvus{fakeMilvus: h.fakeMilvus, ctx: ctx}
+}
+
+func (h *hangingMilvus) ServerStatus() (string, error) {
+	<-h.ctx.Done()
+
+	return "", h.ctx.Err()
+}
+
+func TestSyncerHealthChecksTheTargetsInParallelWithTheContext(t *testing.T) {
+	targets := []IMilvusClientInterface{
+		&hangingMilvus{fakeMilvus: &fakeMilvus{}},
+		&hangingMilvus{fakeMilvus: &fakeMilvus{}},
+		&hangingMilvus{fakeMilvus: &fakeMilvus{}},
+		&fakeMilvus{},
+	}
+	s := newSyncer(targets, newOptions())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	health := s.health(ctx)
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("the health check took %v, the targets are not checked in parallel with the context", elapsed)
+	}
+
+	for i, target := range health[:3] {
+		if target.Target != i || target.Connected || target.Error == "" {
+			t.Fatalf("the hanging target %d is reported %+v", i, target)
+		}
+	}
+
+	if !health[3].Connected || health[3].Status != "ok" {
+		t.Fatalf("the healthy target is reported %+v", health[3])
+	}
+}
+
+// checkedBroker reports a healthy broker
+type checkedBroker struct {
+	stubBroker
+}
+
+func (checkedBroker) Health(_ context.Context) BrokerHealth {
+	return BrokerHealth{Alive: true}
+}
+
+func TestHealthServerReportsBrokersWithoutHealthAsUnknown(t *testing.T) {
+	brokers := map[string]IBrokerFactory{"checked": checkedBroker{}}
+	hs := newHealthServer(":0", func() map[string]IBrokerFactory {
+		return brokers
+	})
+
+	hs.check(context.Background())
+	if report := hs.Report(); !report.Live || !report.Ready {
+		t.Fatalf("the report of a healthy broker is live %v and ready %v", report.Live, report.Ready)
+	}
+
+	brokers["stub"] = stubBroker{}
+	hs.check(context.Background())
+
+	report := hs.Report()
+	if !report.Live || report.Ready {
+		t.Fatalf("the report is live %v and ready %v, wanted live and not ready", report.Live, report.Ready)
+	}
+
+	for _, broker := range report.Brokers {
+		if broker.Unknown != (broker.Broker == "stub") {
+			t.Fatalf("the broker %v is reported %+v", broker.Broker, broker)
+		}
+	}
+}
@@ -0,0 +1,504 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
+)
+
+// fakeCall is a call received by fakeMilvus, the vectors are kept hex encoded
+type fakeCall struct {
+	Op             string
+	CollectionName string
+	PartitionTag   string
+	Ids            []int64
+	Vectors        []string
+}
+
+// fakeMilvus records the calls it receives, fail decides per call whether it fails
+type fakeMilvus struct {
+	mu           sync.Mutex
+	calls        []fakeCall
+	fail         func(call fakeCall) error
+	disconnected bool
+	closed       bool
+}
+
+func (f *fakeMilvus) record(call fakeCall) error {
+	f.mu.Lock()
+	fail := f.fail
+	f.mu.Unlock()
+
+	if fail != nil {
+		if err := fail(call); err != nil {
+			return err
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, call)
+
+	return nil
+}
+
+func (f *fakeMilvus) setFail(fail func(call fakeCall) error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.fail = fail
+}
+
+func (f *fakeMilvus) Calls() []fakeCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.calls)
+}
+
+// Ids returns the ids of the inserts and deletes in the order they were applied, deletes are negated
+func (f *fakeMilvus) Ids() []int64 {
+	var ids []int64
+	for _, call := range f.Calls() {
+		for _, id := range call.Ids {
+			if call.Op == Delete {
+				id = -id
+			}
+
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+func (f *fakeMilvus) Insert(vector, collectionName, partitionTag string, id int64) error {
+	return f.InsertBatch([]string{vector}, collectionName, partitionTag, []int64{id})
+}
+
+func (f *fakeMilvus) InsertBatch(vectors []string, collectionName, partitionTag string, ids []int64) error {
+	return f.record(fakeCall{Op: Insert, CollectionName: collectionName, PartitionTag: partitionTag, Ids: ids, Vectors: vectors})
+}
+
+func (f *fakeMilvus) Delete(collectionName, partitionTag string, id int64) error {
+	return f.DeleteBatch(collectionName, partitionTag, []int64{id})
+}
+
+func (f *fakeMilvus) DeleteBatch(collectionName, partitionTag string, ids []int64) error {
+	return f.record(fakeCall{Op: Delete, CollectionName: collectionName, PartitionTag: partitionTag, Ids: ids})
+}
+
+func (f *fakeMilvus) DropCollection(collectionName string) error {
+	return f.record(fakeCall{Op: DropCollection, CollectionName: collectionName})
+}
+
+func (f *fakeMilvus) CreateCollection(collectionName string, _, _ int64, _ milvus.MetricType) error {
+	return f.record(fakeCall{Op: CreateCollection, CollectionName: collectionName})
+}
+
+func (f *fakeMilvus) CreateIndex(collectionName string, _ int64, _ milvus.IndexType) error {
+	return f.record(fakeCall{Op: CreateIndex, CollectionName: collectionName})
+}
+
+func (f *fakeMilvus) DropIndex(collectionName string) error {
+	return f.record(fakeCall{Op: DropIndex, CollectionName: collectionName})
+}
+
+func (f *fakeMilvus) CreatePartition(collectionName, partitionTag string) error {
+	return f.record(fakeCall{Op: CreatePartition, CollectionName: collectionName, PartitionTag: partitionTag})
+}
+
+func (f *fakeMilvus) DropPartition(collectionName, partitionTag string) error {
+	return f.record(fakeCall{Op: DropPartition, CollectionName: collectionName, PartitionTag: partitionTag})
+}
+
+func (f *fakeMilvus) LoadCollection(collectionName string) error {
+	return f.record(fakeCall{Op: LoadCollection, CollectionName: collectionName})
+}
+
+func (f *fakeMilvus) ReleaseCollection(collectionName string) error {
+	return f.record(fakeCall{Op: ReleaseCollection, CollectionName: collectionName})
+}
+
+func (f *fakeMilvus) IsConnected() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return !f.disconnected
+}
+
+func (f *fakeMilvus) ServerStatus() (string, error) {
+	if !f.IsConnected() {
+		return "", fmt.Errorf("milvus is not connected")
+	}
+
+	return "ok", nil
+}
+
+func (f *fakeMilvus) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+
+	return nil
+}
+
+var errUnavailable = &MilvusError{Op: "fake", Class: ErrorClassTransient, Err: fmt.Errorf("unavailable")}
+
+// newTestRedis starts an in-memory redis that is closed with the test
+func newTestRedis(t *testing.T) (*miniredis.Miniredis, *redis.Client) {
+	t.Helper()
+
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return server, client
+}
+
+// insertPayload encodes an insert of id into collection with a one dimension vector
+func insertPayload(t *testing.T, collectionName string, id, sequence int64) string {
+	t.Helper()
+
+	return payload(t, &MessageCDC{
+		Action:         Insert,
+		CollectionName: collectionName,
+		Id:             id,
+		Vector:         EncodeVector([]float32{float32(id)}),
+		Sequence:       sequence,
+	})
+}
+
+func payload(t *testing.T, message *MessageCDC) string {
+	t.Helper()
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		t.Fatalf("marshal message is failed with err %v", err)
+	}
+
+	return string(data)
+}
+
+// eventually fails the test when cond does not hold within 5 seconds
+func eventually(t *testing.T, cond func() bool, format string, args ...interface{}) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf(format, args...)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// startBroker runs Start in the background and stops the broker with the test
+func startBroker(t *testing.T, broker IBrokerFactory, channel, pattern string) {
+	t.Helper()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- broker.Start(channel, pattern)
+	}()
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err := broker.Stop(ctx)
+		if err != nil {
+			t.Errorf("stop broker is failed with err %v", err)
+		}
+
+		select {
+		case err = <-done:
+			if err != nil {
+				t.Errorf("start broker is failed with err %v", err)
+			}
+		case <-ctx.Done():
+			t.Errorf("start broker did not return after stop")
+		}
+	})
+}
+
+// gauge reads a gauge or counter of m whose labels hold the given value, it is -1 when the metric is not set. The
+// dead letters gauge has no label and is read with an empty value
+func gauge(t *testing.T, m *Metrics, name, label string) float64 {
+	t.Helper()
+
+	families, err := m.registry.Gather()
+	if err != nil {
+		t.Fatalf("gather metrics is failed with err %v", err)
+	}
+
+	for _, family := range families {
+		if family.GetName() != MetricsNamespace+"_"+name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			if label == "" || slices.ContainsFunc(metric.GetLabel(), func(pair *dto.LabelPair) bool {
+				return pair.GetValue() == label
+			}) {
+				if metric.GetCounter() != nil {
+					return metric.GetCounter().GetValue()
+				}
+
+				return metric.GetGauge().GetValue()
+			}
+		}
+	}
+
+	return -1
+}
+
+// recordingTracer records the names of the spans it starts, every span gets its own id in the trace of its parent so
+// the propagated trace context can be told apart
+type recordingTracer struct {
+	embedded.Tracer
+
+	mu    sync.Mutex
+	names []string
+	next  byte
+}
+
+// recordingProvider hands out its tracer for every name
+type recordingProvider struct {
+	embedded.TracerProvider
+
+	tracer *recordingTracer
+}
+
+func newRecordingProvider() *recordingProvider {
+	return &recordingProvider{tracer: &recordingTracer{}}
+}
+
+func (r *recordingProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return r.tracer
+}
+
+func (r *recordingTracer) Start(ctx context.Context, name string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.names = append(r.names, name)
+	r.next++
+
+	traceID := trace.SpanContextFromContext(ctx).TraceID()
+	if !traceID.IsValid() {
+		traceID = trace.TraceID{r.next}
+	}
+
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     trace.SpanID{r.next},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	ctx = trace.ContextWithSpanContext(ctx, spanCtx)
+
+	return ctx, trace.SpanFromContext(ctx)
+}
+
+func (r *recordingTracer) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Clone(r.names)
+}
+
+// fakeSnapshot is an in-memory snapshot source, every partition is one segment named after it and the default
+// partition always exists. onList runs at the start of ListCollections
+type fakeSnapshot struct {
+	mu          sync.Mutex
+	collections map[string]*fakeCollection
+	onList      func()
+}
+
+type fakeCollection struct {
+	param   milvus.CollectionParam
+	index   milvus.IndexParam
+	order   []string
+	vectors map[string]map[int64][]float32
+}
+
+// add stores the entities of ids with the vector [id] in the partition, creating the collection and the partition
+func (f *fakeSnapshot) add(collectionName, partitionTag string, ids ...int64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.collections == nil {
+		f.collections = make(map[string]*fakeCollection)
+	}
+
+	collection, ok := f.collections[collectionName]
+	if !ok {
+		collection = &fakeCollection{
+			param:   milvus.CollectionParam{CollectionName: collectionName, Dimension: 1, IndexFileSize: 1024, MetricType: int32(milvus.L2)},
+			index:   milvus.IndexParam{CollectionName: collectionName, IndexType: milvus.FLAT},
+			order:   []string{DefaultPartitionTag},
+			vectors: map[string]map[int64][]float32{DefaultPartitionTag: {}},
+		}
+		f.collections[collectionName] = collection
+	}
+
+	if partitionTag == "" {
+		partitionTag = DefaultPartitionTag
+	}
+
+	if _, ok = collection.vectors[partitionTag]; !ok {
+		collection.order = append(collection.order, partitionTag)
+		collection.vectors[partitionTag] = make(map[int64][]float32)
+	}
+
+	for _, id := range ids {
+		collection.vectors[partitionTag][id] = []float32{float32(id)}
+	}
+}
+
+func (f *fakeSnapshot) collection(collectionName string) (*fakeCollection, error) {
+	collection, ok := f.collections[collectionName]
+	if !ok {
+		return nil, fmt.Errorf("collection %v not exists", collectionName)
+	}
+
+	return collection, nil
+}
+
+func (f *fakeSnapshot) ListCollections() ([]string, error) {
+	if f.onList != nil {
+		f.onList()
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	names := make([]string, 0, len(f.collections))
+	for name := range f.collections {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+func (f *fakeSnapshot) GetCollectionInfo(collectionName string) (milvus.CollectionParam, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	collection, err := f.collection(collectionName)
+	if err != nil {
+		return milvus.CollectionParam{}, err
+	}
+
+	return collection.param, nil
+}
+
+func (f *fakeSnapshot) ListPartitions(collectionName string) ([]string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	collection, err := f.collection(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.Clone(collection.order), nil
+}
+
+func (f *fakeSnapshot) GetIndexInfo(collectionName string) (milvus.IndexParam, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	collection, err := f.collection(collectionName)
+	if err != nil {
+		return milvus.IndexParam{}, err
+	}
+
+	return collection.index, nil
+}
+
+func (f *fakeSnapshot) ShowCollectionInfo(collectionName string) (*CollectionStats, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	collection, err := f.collection(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &CollectionStats{}
+	for _, tag := range collection.order {
+		rows := int64(len(collection.vectors[tag]))
+		stats.RowCount += rows
+		stats.Partitions = append(stats.Partitions, PartitionStats{
+			Tag:      tag,
+			RowCount: rows,
+			Segments: []SegmentStats{{Name: tag, RowCount: rows}},
+		})
+	}
+
+	return stats, nil
+}
+
+func (f *fakeSnapshot) ListIDInSegment(collectionName, segmentName string) ([]int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	collection, err := f.collection(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int64, 0, len(collection.vectors[segmentName]))
+	for id := range collection.vectors[segmentName] {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// GetEntityByID returns an entity without data for the ids that do not exist, as Milvus does
+func (f *fakeSnapshot) GetEntityByID(collectionName, partitionTag string, ids []int64) ([]milvus.Entity, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	collection, err := f.collection(collectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]milvus.Entity, 0, len(ids))
+	for _, id := range ids {
+		var vector []float32
+		for tag, vectors := range collection.vectors {
+			if partitionTag == "" || partitionTag == tag {
+				if v, ok := vectors[id]; ok {
+					vector = v
+				}
+			}
+		}
+
+		entities = append(entities, milvus.Entity{FloatData: vector})
+	}
+
+	return entities, nil
+}
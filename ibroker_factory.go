@@ -1,6 +1,8 @@
 package milvus_cdc
 
+import "context"
+
 type IBrokerFactory interface {
 	Start(channel, pattern string) error
-	Stop()
+	Stop(ctx context.Context) error
 }
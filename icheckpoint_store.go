@@ -0,0 +1,10 @@
+package milvus_cdc
+
+import "context"
+
+// ICheckpointStore keeps the highest sequence applied per target and collection, a target is identified by its name or
+// address so reordering the targets keeps their checkpoints
+type ICheckpointStore interface {
+	Get(ctx context.Context, target string, collectionName string) (int64, error)
+	Set(ctx context.Context, target string, collectionName string, sequence int64) error
+}
@@ -0,0 +1,11 @@
+package milvus_cdc
+
+import "context"
+
+type IDeadLetterSink interface {
+	Put(ctx context.Context, letter *DeadLetter) (string, error)
+	List(ctx context.Context, start string, count int64) ([]*DeadLetter, error)
+	Get(ctx context.Context, id string) (*DeadLetter, error)
+	Delete(ctx context.Context, id string) error
+	Len(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,18 @@
+package milvus_cdc
+
+import (
+	"context"
+	"time"
+)
+
+// IEventLog is an append-only log of the messages received by the brokers, it keeps them after the broker dropped
+// them so a replica can be rebuilt to a past state
+type IEventLog interface {
+	// Append records the entry and returns its position
+	Append(ctx context.Context, entry *LogEntry) (int64, error)
+	// Read returns at most count entries from position from on, ordered by position
+	Read(ctx context.Context, from int64, count int) ([]*LogEntry, error)
+	// Seek returns the position of the first entry recorded at or after timestamp, or the position the next entry
+	// gets when there is none
+	Seek(ctx context.Context, timestamp time.Time) (int64, error)
+}
@@ -0,0 +1,7 @@
+package milvus_cdc
+
+import "context"
+
+type IHealthChecker interface {
+	Health(ctx context.Context) BrokerHealth
+}
@@ -1,12 +1,21 @@
 package milvus_cdc
 
+import "github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+
 type IMilvusClientInterface interface {
 	Insert(vector, collectionName, partitionTag string, id int64) error
+	InsertBatch(vectors []string, collectionName, partitionTag string, ids []int64) error
 	Delete(collectionName, partitionTag string, id int64) error
+	DeleteBatch(collectionName, partitionTag string, ids []int64) error
 	DropCollection(collectionName string) error
-	CreateCollection(collectionName string, dimension, indexSize int64, metric int32) error
-	CreateIndex(collectionName, extraParams string, indexType int64) error
+	CreateCollection(collectionName string, dimension, indexSize int64, metric milvus.MetricType) error
+	CreateIndex(collectionName string, nList int64, indexType milvus.IndexType) error
 	DropIndex(collectionName string) error
 	CreatePartition(collectionName, partitionTag string) error
 	DropPartition(collectionName, partitionTag string) error
+	LoadCollection(collectionName string) error
+	ReleaseCollection(collectionName string) error
+	IsConnected() bool
+	ServerStatus() (string, error)
+	Close() error
 }
@@ -0,0 +1,9 @@
+package milvus_cdc
+
+import "context"
+
+// IMilvusContextClientInterface is implemented by targets whose RPCs can run under the context of the sync, so its
+// span and cancellation reach Milvus
+type IMilvusContextClientInterface interface {
+	WithContext(ctx context.Context) IMilvusClientInterface
+}
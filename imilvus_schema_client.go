@@ -0,0 +1,10 @@
+package milvus_cdc
+
+import "github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+
+// IMilvusSchemaClientInterface is implemented by targets that support scalar fields, such as Milvus 2.x
+type IMilvusSchemaClientInterface interface {
+	InsertWithFields(vector, collectionName, partitionTag string, id int64, fields map[string]interface{}) error
+	InsertBatchWithFields(vectors []string, collectionName, partitionTag string, ids []int64, fields []map[string]interface{}) error
+	CreateCollectionWithSchema(collectionName string, dimension int64, metric milvus.MetricType, fields []FieldSchema) error
+}
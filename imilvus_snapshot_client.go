@@ -0,0 +1,15 @@
+package milvus_cdc
+
+import "github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+
+// IMilvusSnapshotClientInterface is implemented by sources that can be read entity by entity, such as Milvus 1.x, to
+// bootstrap a new replica
+type IMilvusSnapshotClientInterface interface {
+	ListCollections() ([]string, error)
+	GetCollectionInfo(collectionName string) (milvus.CollectionParam, error)
+	ListPartitions(collectionName string) ([]string, error)
+	GetIndexInfo(collectionName string) (milvus.IndexParam, error)
+	ShowCollectionInfo(collectionName string) (*CollectionStats, error)
+	ListIDInSegment(collectionName, segmentName string) ([]int64, error)
+	GetEntityByID(collectionName, partitionTag string, ids []int64) ([]milvus.Entity, error)
+}
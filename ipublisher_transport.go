@@ -0,0 +1,10 @@
+package milvus_cdc
+
+import "context"
+
+// IPublisherTransport sends an encoded message to the channel of a broker and returns the id the broker assigned to
+// it, transports of brokers that assign no id return the event id of the message
+type IPublisherTransport interface {
+	Send(ctx context.Context, message *MessageCDC, payload []byte) (string, error)
+	Close() error
+}
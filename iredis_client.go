@@ -12,4 +12,27 @@ type IRedisClientInterface interface {
 	Publish(ctx context.Context, channel, message string) (int64, error)
 	LPush(ctx context.Context, queue string, value interface{}) (int64, error)
 	BRPop(ctx context.Context, queue string, timeout time.Duration) ([]string, error)
+	XAdd(ctx context.Context, stream string, values map[string]interface{}) (string, error)
+	XGroupCreateMkStream(ctx context.Context, stream, group, start string) error
+	XReadGroup(ctx context.Context, args *redis.XReadGroupArgs) ([]redis.XStream, error)
+	XAck(ctx context.Context, stream, group string, ids ...string) (int64, error)
+	XAutoClaim(ctx context.Context, args *redis.XAutoClaimArgs) ([]redis.XMessage, string, error)
+	XInfoGroup(ctx context.Context, stream, group string) (int64, int64, error)
+	XPendingExt(ctx context.Context, args *redis.XPendingExtArgs) ([]redis.XPendingExt, error)
+	XRangeN(ctx context.Context, stream, start, stop string, count int64) ([]redis.XMessage, error)
+	XRevRangeN(ctx context.Context, stream, start, stop string, count int64) ([]redis.XMessage, error)
+	XDel(ctx context.Context, stream string, ids ...string) (int64, error)
+	LPushAll(ctx context.Context, queues []string, value interface{}, capacity int64) ([]int, error)
+	RPush(ctx context.Context, queue string, value interface{}) (int64, error)
+	BRPopLPush(ctx context.Context, source, destination string, timeout time.Duration) (string, error)
+	RPopLPush(ctx context.Context, source, destination string) (string, error)
+	LMove(ctx context.Context, source, destination, srcPos, destPos string) (string, error)
+	LRem(ctx context.Context, queue string, count int64, value interface{}) (int64, error)
+	LLen(ctx context.Context, queue string) (int64, error)
+	HGet(ctx context.Context, key, field string) (string, error)
+	XLen(ctx context.Context, stream string) (int64, error)
+	HSetMax(ctx context.Context, key, field string, value int64) error
+	Incr(ctx context.Context, key string) (int64, error)
+	Ping(ctx context.Context) error
+	Close() error
 }
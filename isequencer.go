@@ -0,0 +1,9 @@
+package milvus_cdc
+
+import "context"
+
+// ISequencer hands out the sequence of the messages of a collection, every call returns a number higher than the
+// previous ones of the collection so the checkpoints of the targets can tell the applied messages apart
+type ISequencer interface {
+	Next(ctx context.Context, collectionName string) (int64, error)
+}
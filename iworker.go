@@ -1,6 +1,9 @@
 package milvus_cdc
 
+import "context"
+
 type IWorkerInterface interface {
 	Start(broker, channel, pattern string) error
-	Stop(broker string) error
+	StartAll(subscriptions ...Subscription) error
+	Stop(ctx context.Context, broker string) error
 }
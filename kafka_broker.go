@@ -0,0 +1,338 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/sirupsen/logrus"
+)
+
+// kafkaReader is the part of kafka.Reader the broker uses
+type kafkaReader interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, messages ...kafka.Message) error
+	Stats() kafka.ReaderStats
+	Close() error
+}
+
+// kafkaConsumer is a reader of the running broker and the targets it feeds
+type kafkaConsumer struct {
+	queue   string
+	reader  kafkaReader
+	targets []int
+}
+
+type KafkaBroker struct {
+	brokers   []string
+	syncer    *syncer
+	opts      *options
+	lifecycle *lifecycle
+	newReader func(config kafka.ReaderConfig) kafkaReader
+	mu        sync.Mutex
+	consumers []kafkaConsumer
+}
+
+func newKafkaReader(config kafka.ReaderConfig) kafkaReader {
+	return kafka.NewReader(config)
+}
+
+func NewKafkaBroker(brokers []string, milvus []IMilvusClientInterface, opts ...Option) *KafkaBroker {
+	o := newOptions(opts...)
+
+	kb := &KafkaBroker{
+		brokers: brokers,
+		syncer:  newSyncer(milvus, o),
+		opts:    o,
+
+		newReader: newKafkaReader,
+	}
+
+	kb.lifecycle = newLifecycle()
+
+	return kb
+}
+
+func (kb *KafkaBroker) Start(channel, pattern string) error {
+	kb.lifecycle.start()
+
+	kb.mu.Lock()
+	kb.consumers = nil
+	kb.mu.Unlock()
+
+	switch pattern {
+	case PubSub:
+		// every target gets its own consumer group so each of them receives all messages of the topic and keeps its
+		// offsets across restarts
+		for _, target := range kb.syncer.targets() {
+			group := fmt.Sprintf("%s-%s", kb.opts.consumerGroup, kb.syncer.targetId(target))
+			kb.consume(channel, group, []int{target})
+		}
+	case Queue:
+		kb.consume(channel, kb.opts.consumerGroup, kb.syncer.targets())
+	default:
+		return fmt.Errorf("pattern is invalid")
+	}
+
+	if kb.opts.metrics != nil {
+		kb.lifecycle.spawn(func() {
+			report(kb.lifecycle.consuming(), kb.opts.metricsPeriod, kb.refreshGauges)
+		})
+	}
+
+	kb.lifecycle.wait()
+
+	return nil
+}
+
+// Stop stops fetching new messages and waits until ctx is done for the messages in flight, the readers are closed and
+// the Milvus connections stay open for the caller. Messages left unprocessed are not committed so the consumer group receives them again after a restart
+func (kb *KafkaBroker) Stop(ctx context.Context) error {
+	return kb.lifecycle.stop(ctx)
+}
+
+// Health checks every target, the readers connect to the Kafka brokers on their own so they are not checked
+func (kb *KafkaBroker) Health(ctx context.Context) BrokerHealth {
+	return BrokerHealth{
+		Broker:  Kafka,
+		Alive:   kb.lifecycle.alive(),
+		Targets: kb.syncer.health(ctx),
+	}
+}
+
+// refreshGauges reads the lag of every consumer group from its reader, the lag is the depth of the group and the lag
+// of the targets it feeds
+func (kb *KafkaBroker) refreshGauges(ctx context.Context) {
+	kb.mu.Lock()
+	consumers := slices.Clone(kb.consumers)
+	kb.mu.Unlock()
+
+	for _, consumer := range consumers {
+		lag := consumer.reader.Stats().Lag
+		if lag < 0 {
+			continue
+		}
+
+		kb.opts.metrics.setQueueDepth(consumer.queue, lag)
+		for _, target := range consumer.targets {
+			kb.opts.metrics.setLag(kb.syncer.targetId(target), lag)
+		}
+	}
+
+	count, err := kb.syncer.deadLetters(ctx)
+	if err == nil {
+		kb.opts.metrics.setDeadLetters(count)
+	}
+}
+
+// consume commits the messages of the group once the given targets applied them, messages of one collection are
+// applied in order while other collections are applied in parallel
+func (kb *KafkaBroker) consume(topic, group string, targets []int) {
+	reader := kb.newReader(kafka.ReaderConfig{
+		Brokers: kb.brokers,
+		GroupID: group,
+		Topic:   topic,
+		MaxWait: kb.opts.readBlock,
+	})
+
+	kb.mu.Lock()
+	kb.consumers = append(kb.consumers, kafkaConsumer{
+		queue:   fmt.Sprintf("%s/%s", topic, group),
+		reader:  reader,
+		targets: targets,
+	})
+	kb.mu.Unlock()
+
+	kb.lifecycle.spawn(func() {
+		defer func() {
+			err := reader.Close()
+			if err != nil {
+				logrus.Errorf("close reader of topic %v is failed with err %v", topic, err)
+			}
+		}()
+
+		offsets := newKafkaOffsets()
+		executor := newPartitionedExecutor(kb.lifecycle.work(), kb.opts.partitions, kb.opts.batchSize, kb.opts.batchLatency, kafkaPayload, func(messages []kafka.Message) {
+			defer kb.lifecycle.end(len(messages))
+
+			payloads := make([]string, 0, len(messages))
+			for _, message := range messages {
+				payloads = append(payloads, string(message.Value))
+			}
+
+			// messages that were not applied are never committed, the group receives them again after a restart
+			if !kb.handle(kb.lifecycle.consuming(), payloads, targets) {
+				return
+			}
+
+			offsets.commit(messages, func(commits []kafka.Message) {
+				err := reader.CommitMessages(kb.lifecycle.work(), commits...)
+				if err != nil {
+					logrus.Errorf("commit offsets of topic %v is failed with err %v", topic, err)
+				}
+			})
+		})
+		// the lanes drain the messages they already accepted once consuming stops
+		defer executor.close()
+
+		for {
+			message, err := reader.FetchMessage(kb.lifecycle.consuming())
+			if err != nil {
+				if kb.lifecycle.stopping() {
+					return
+				}
+
+				logrus.Errorf("fetch message of topic %v is failed with err %v", topic, err)
+				sleep(kb.lifecycle.consuming(), kb.opts.retryDelay)
+				continue
+			}
+
+			offsets.fetched(message)
+			kb.syncer.received([]string{string(message.Value)}, targets)
+
+			// every target may have a group of its own, so the messages are recorded by the group of the first one
+			if slices.Contains(targets, 0) {
+				kb.syncer.record(string(message.Value))
+			}
+
+			kb.lifecycle.begin(1)
+			if !executor.submit(kb.lifecycle.work(), message) {
+				kb.lifecycle.end(1)
+				return
+			}
+		}
+	})
+}
+
+// handle retries the targets that failed until all of them applied the payloads or the broker stops, committing past
+// a message that was not applied would lose it for the failed targets
+func (kb *KafkaBroker) handle(ctx context.Context, payloads []string, targets []int) bool {
+	failed, invalid := kb.syncer.handleDelivered(kb.lifecycle.work(), payloads, attempts(len(payloads), 1), targets)
+	for i, err := range invalid {
+		if err != nil {
+			logrus.Errorf("message %v is invalid with err %v, commit and skip it", payloads[i], err)
+		}
+	}
+
+	// a target that failed a payload also failed the payloads after it, so the rest is retried in order
+	retries := make(map[int]int)
+	for i := len(payloads) - 1; i >= 0; i-- {
+		for _, target := range failed[i] {
+			retries[target] = i
+		}
+	}
+
+	for target, from := range retries {
+		for attempt := 2; ; attempt++ {
+			select {
+			case <-ctx.Done():
+				return false
+			case <-time.After(kb.opts.retryDelay):
+			}
+
+			// a payload is buried once it failed maxDeliver times, so the retries end with a dead letter sink
+			failed, _ = kb.syncer.handleDelivered(kb.lifecycle.work(), payloads[from:], attempts(len(payloads)-from, attempt), []int{target})
+			next := slices.IndexFunc(failed, func(targets []int) bool {
+				return len(targets) > 0
+			})
+			if next < 0 {
+				break
+			}
+
+			from += next
+		}
+	}
+
+	return true
+}
+
+// attempts counts every payload of a retry as delivered attempt times
+func attempts(n, attempt int) []int {
+	deliveries := make([]int, n)
+	for i := range deliveries {
+		deliveries[i] = attempt
+	}
+
+	return deliveries
+}
+
+func kafkaPayload(message kafka.Message) string {
+	return string(message.Value)
+}
+
+// kafkaOffsets commits the offset of a partition only once every message fetched before it is applied, the lanes
+// apply messages of other collections out of order
+type kafkaOffsets struct {
+	mu         sync.Mutex
+	partitions map[int]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	fetched []int64
+	applied map[int64]kafka.Message
+}
+
+func newKafkaOffsets() *kafkaOffsets {
+	return &kafkaOffsets{
+		partitions: make(map[int]*partitionOffsets),
+	}
+}
+
+func (ko *kafkaOffsets) fetched(message kafka.Message) {
+	ko.mu.Lock()
+	defer ko.mu.Unlock()
+
+	partition, ok := ko.partitions[message.Partition]
+	if !ok {
+		partition = &partitionOffsets{applied: make(map[int64]kafka.Message)}
+		ko.partitions[message.Partition] = partition
+	}
+
+	partition.fetched = append(partition.fetched, message.Offset)
+}
+
+// commit marks the messages applied and commits the highest contiguous offset of their partitions, fn runs under the
+// lock so a lower offset is never committed after a higher one
+func (ko *kafkaOffsets) commit(messages []kafka.Message, fn func(commits []kafka.Message)) {
+	ko.mu.Lock()
+	defer ko.mu.Unlock()
+
+	touched := make(map[int]bool)
+	for _, message := range messages {
+		ko.partitions[message.Partition].applied[message.Offset] = message
+		touched[message.Partition] = true
+	}
+
+	var commits []kafka.Message
+	for id := range touched {
+		partition := ko.partitions[id]
+
+		var (
+			last kafka.Message
+			done int
+		)
+
+		for _, offset := range partition.fetched {
+			message, ok := partition.applied[offset]
+			if !ok {
+				break
+			}
+
+			delete(partition.applied, offset)
+			last = message
+			done++
+		}
+
+		if done > 0 {
+			partition.fetched = partition.fetched[done:]
+			commits = append(commits, last)
+		}
+	}
+
+	if len(commits) > 0 {
+		fn(commits)
+	}
+}
@@ -0,0 +1,287 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// fakeKafka is an in-process topic, every consumer group keeps the offset of its last committed message
+type fakeKafka struct {
+	mu        sync.Mutex
+	messages  []kafka.Message
+	committed map[string]int64
+	groups    []string
+	fetchErr  error
+	fetches   int
+}
+
+func newFakeKafka() *fakeKafka {
+	return &fakeKafka{
+		committed: make(map[string]int64),
+	}
+}
+
+func (f *fakeKafka) produce(value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.messages = append(f.messages, kafka.Message{Offset: int64(len(f.messages)), Value: []byte(value)})
+}
+
+func (f *fakeKafka) setFetchErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.fetchErr = err
+}
+
+func (f *fakeKafka) Committed(group string) int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.committed[group]
+}
+
+func (f *fakeKafka) Fetches() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.fetches
+}
+
+func (f *fakeKafka) newReader(config kafka.ReaderConfig) kafkaReader {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.groups = append(f.groups, config.GroupID)
+
+	return &fakeKafkaReader{kafka: f, group: config.GroupID, next: f.committed[config.GroupID]}
+}
+
+type fakeKafkaReader struct {
+	kafka *fakeKafka
+	group string
+	next  int64
+}
+
+func (r *fakeKafkaReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	for {
+		r.kafka.mu.Lock()
+		r.kafka.fetches++
+		if r.kafka.fetchErr != nil {
+			err := r.kafka.fetchErr
+			r.kafka.mu.Unlock()
+			return kafka.Message{}, err
+		}
+
+		if r.next < int64(len(r.kafka.messages)) {
+			message := r.kafka.messages[r.next]
+			r.next++
+			r.kafka.mu.Unlock()
+			return message, nil
+		}
+		r.kafka.fetches--
+		r.kafka.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return kafka.Message{}, ctx.Err()
+		case <-time.After(5 * time.Millisecond):
+		}
+	}
+}
+
+func (r *fakeKafkaReader) CommitMessages(_ context.Context, messages ...kafka.Message) error {
+	r.kafka.mu.Lock()
+	defer r.kafka.mu.Unlock()
+
+	for _, message := range messages {
+		r.kafka.committed[r.group] = max(r.kafka.committed[r.group], message.Offset+1)
+	}
+
+	return nil
+}
+
+// Stats reports the messages the group did not commit as its lag
+func (r *fakeKafkaReader) Stats() kafka.ReaderStats {
+	r.kafka.mu.Lock()
+	defer r.kafka.mu.Unlock()
+
+	return kafka.ReaderStats{Lag: int64(len(r.kafka.messages)) - r.kafka.committed[r.group]}
+}
+
+func (r *fakeKafkaReader) Close() error {
+	return nil
+}
+
+func newTestKafkaBroker(topic *fakeKafka, targets []IMilvusClientInterface, opts ...Option) *KafkaBroker {
+	opts = append([]Option{WithRetryDelay(20 * time.Millisecond)}, opts...)
+	broker := NewKafkaBroker([]string{"fake:9092"}, targets, opts...)
+	broker.newReader = topic.newReader
+
+	return broker
+}
+
+func TestKafkaBrokerQueueCommitsAfterEveryTarget(t *testing.T) {
+	topic := newFakeKafka()
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+	second.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	broker := newTestKafkaBroker(topic, []IMilvusClientInterface{first, second})
+	startBroker(t, broker, "cdc", Queue)
+
+	topic.produce(insertPayload(t, "c", 1, 0))
+
+	eventually(t, func() bool {
+		return len(first.Calls()) > 0
+	}, "the message is not applied to the healthy target")
+
+	if committed := topic.Committed(DefaultConsumerGroup); committed != 0 {
+		t.Fatalf("the offset is committed to %d before every target applied the message", committed)
+	}
+
+	second.setFail(nil)
+
+	eventually(t, func() bool {
+		return slices.Equal(second.Ids(), []int64{1}) && topic.Committed(DefaultConsumerGroup) == 1
+	}, "the message is not committed after every target applied it")
+}
+
+func TestKafkaBrokerPubSubUsesStableGroupPerTarget(t *testing.T) {
+	topic := newFakeKafka()
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+	second.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	broker := newTestKafkaBroker(topic, []IMilvusClientInterface{first, second},
+		WithTargetName(0, "primary"), WithTargetName(1, "replica"))
+	startBroker(t, broker, "cdc", PubSub)
+
+	topic.produce(insertPayload(t, "c", 1, 0))
+
+	primary, replica := DefaultConsumerGroup+"-primary", DefaultConsumerGroup+"-replica"
+	eventually(t, func() bool {
+		return topic.Committed(primary) == 1
+	}, "the group of the healthy target does not commit")
+
+	if committed := topic.Committed(replica); committed != 0 {
+		t.Fatalf("the group of the failing target is committed to %d", committed)
+	}
+
+	second.setFail(nil)
+
+	eventually(t, func() bool {
+		return topic.Committed(replica) == 1
+	}, "the group of the recovered target does not commit")
+
+	topic.mu.Lock()
+	groups := slices.Clone(topic.groups)
+	topic.mu.Unlock()
+
+	slices.Sort(groups)
+	if !slices.Equal(groups, []string{primary, replica}) {
+		t.Fatalf("the consumer groups are %v", groups)
+	}
+}
+
+func TestKafkaBrokerBacksOffOnFetchErrors(t *testing.T) {
+	topic := newFakeKafka()
+	topic.setFetchErr(fmt.Errorf("broker is not available"))
+
+	broker := newTestKafkaBroker(topic, []IMilvusClientInterface{&fakeMilvus{}}, WithRetryDelay(50*time.Millisecond))
+	startBroker(t, broker, "cdc", Queue)
+
+	time.Sleep(200 * time.Millisecond)
+
+	if fetches := topic.Fetches(); fetches > 6 {
+		t.Fatalf("the reader fetched %d times in 200ms with a retry delay of 50ms", fetches)
+	}
+}
+
+func TestKafkaBrokerBatchesInsertsOfACollection(t *testing.T) {
+	topic := newFakeKafka()
+	target := &fakeMilvus{}
+
+	for id := int64(1); id <= 3; id++ {
+		topic.produce(insertPayload(t, "c", id, 0))
+	}
+
+	broker := newTestKafkaBroker(topic, []IMilvusClientInterface{target}, WithBatching(3, time.Second))
+	startBroker(t, broker, "cdc", Queue)
+
+	eventually(t, func() bool {
+		return topic.Committed(DefaultConsumerGroup) == 3
+	}, "the batch is not committed")
+
+	calls := target.Calls()
+	if len(calls) != 1 || !slices.Equal(calls[0].Ids, []int64{1, 2, 3}) {
+		t.Fatalf("the inserts are applied with the calls %v, wanted one batch", calls)
+	}
+}
+
+func TestKafkaOffsetsCommitContiguousOffsets(t *testing.T) {
+	offsets := newKafkaOffsets()
+	messages := make([]kafka.Message, 0, 4)
+	for offset := int64(0); offset < 4; offset++ {
+		message := kafka.Message{Partition: 1, Offset: offset}
+		offsets.fetched(message)
+		messages = append(messages, message)
+	}
+
+	var committed []int64
+	commit := func(commits []kafka.Message) {
+		for _, message := range commits {
+			committed = append(committed, message.Offset)
+		}
+	}
+
+	// a later lane finishes first, nothing can be committed before offset 0 is applied
+	offsets.commit(messages[2:3], commit)
+	offsets.commit(messages[1:2], commit)
+	if len(committed) > 0 {
+		t.Fatalf("the offsets %v are committed before offset 0 is applied", committed)
+	}
+
+	offsets.commit(messages[0:1], commit)
+	offsets.commit(messages[3:4], commit)
+	if !slices.Equal(committed, []int64{2, 3}) {
+		t.Fatalf("the committed offsets are %v, wanted [2 3]", committed)
+	}
+}
+
+func TestKafkaBrokerReportsTheLagOfEveryGroup(t *testing.T) {
+	topic := newFakeKafka()
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+	second.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	metrics := NewMetrics()
+	broker := newTestKafkaBroker(topic, []IMilvusClientInterface{first, second},
+		WithMetrics(metrics), WithMetricsInterval(10*time.Millisecond), WithTargetName(0, "primary"), WithTargetName(1, "replica"))
+	startBroker(t, broker, "cdc", PubSub)
+
+	topic.produce(insertPayload(t, "c", 1, 0))
+
+	eventually(t, func() bool {
+		return gauge(t, metrics, "target_lag", "primary") == 0 && gauge(t, metrics, "target_lag", "replica") == 1
+	}, "the lag of the groups is not reported, it is %v and %v", gauge(t, metrics, "target_lag", "primary"), gauge(t, metrics, "target_lag", "replica"))
+
+	// the retries of the replica do not receive the message again
+	eventually(t, func() bool {
+		return gauge(t, metrics, "messages_failed_total", "replica") >= 3
+	}, "the message is not retried, it failed %v times", gauge(t, metrics, "messages_failed_total", "replica"))
+
+	if received := gauge(t, metrics, "messages_received_total", "replica"); received != 1 {
+		t.Fatalf("the message is received %v times by the replica", received)
+	}
+}
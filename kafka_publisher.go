@@ -0,0 +1,92 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const eventIdHeader = "event_id"
+
+// KafkaTransport writes to a topic keyed by collection name, so the messages of a collection share a partition and
+// keep their order. The broker id is the partition and offset
+type KafkaTransport struct {
+	writer  *kafka.Writer
+	mu      sync.Mutex
+	offsets map[string]string
+}
+
+func NewKafkaTransport(brokers []string, topic string) *KafkaTransport {
+	kt := &KafkaTransport{
+		offsets: make(map[string]string),
+	}
+
+	kt.writer = &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		BatchTimeout: DefaultBatchLatency,
+		Completion:   kt.complete,
+	}
+
+	return kt
+}
+
+func NewKafkaPublisher(brokers []string, topic string, opts ...PublisherOption) *Publisher {
+	return NewPublisher(NewKafkaTransport(brokers, topic), opts...)
+}
+
+func (kt *KafkaTransport) Send(ctx context.Context, message *MessageCDC, payload []byte) (string, error) {
+	err := kt.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(message.CollectionName),
+		Value: payload,
+		Headers: []kafka.Header{
+			{Key: eventIdHeader, Value: []byte(message.EventId)},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if id := kt.take(message.EventId); id != "" {
+		return id, nil
+	}
+
+	return message.EventId, nil
+}
+
+// complete runs before WriteMessages returns, it keeps the offsets assigned to the messages for Send
+func (kt *KafkaTransport) complete(messages []kafka.Message, err error) {
+	if err != nil {
+		return
+	}
+
+	kt.mu.Lock()
+	defer kt.mu.Unlock()
+
+	for _, message := range messages {
+		for _, header := range message.Headers {
+			if header.Key == eventIdHeader {
+				kt.offsets[string(header.Value)] = fmt.Sprintf("%d:%d", message.Partition, message.Offset)
+			}
+		}
+	}
+}
+
+func (kt *KafkaTransport) take(eventId string) string {
+	kt.mu.Lock()
+	defer kt.mu.Unlock()
+
+	id := kt.offsets[eventId]
+	delete(kt.offsets, eventId)
+
+	return id
+}
+
+// Close flushes and closes the writer
+func (kt *KafkaTransport) Close() error {
+	return kt.writer.Close()
+}
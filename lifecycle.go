@@ -0,0 +1,152 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// lifecycle lets Stop end the consumers of a broker gracefully. Once Stop is called the consuming context is done so
+// no new message is taken, the messages in flight are still applied with the work context which is only cancelled
+// when Stop gives up waiting for them. The next Start of a stopped broker begins a new run with fresh contexts
+type lifecycle struct {
+	mu  sync.RWMutex
+	run *run
+	// runMu serialises starting and stopping a run
+	runMu    sync.Mutex
+	inFlight atomic.Int64
+}
+
+// run holds the contexts and consumers of one Start to Stop cycle
+type run struct {
+	consuming     context.Context
+	stopConsuming context.CancelFunc
+	work          context.Context
+	abort         context.CancelFunc
+	wg            sync.WaitGroup
+	spawned       atomic.Int64
+	running       atomic.Int64
+	stopped       bool
+	stopErr       error
+}
+
+func newLifecycle() *lifecycle {
+	return &lifecycle{
+		run: newRun(),
+	}
+}
+
+func newRun() *run {
+	r := &run{}
+	r.work, r.abort = context.WithCancel(context.Background())
+	r.consuming, r.stopConsuming = context.WithCancel(r.work)
+
+	return r
+}
+
+func (l *lifecycle) current() *run {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	return l.run
+}
+
+// start begins a new run when the broker was stopped, once the consumers of the previous run returned
+func (l *lifecycle) start() {
+	l.runMu.Lock()
+	defer l.runMu.Unlock()
+
+	previous := l.current()
+	if !previous.stopped {
+		return
+	}
+
+	previous.wg.Wait()
+	l.inFlight.Store(0)
+
+	l.mu.Lock()
+	l.run = newRun()
+	l.mu.Unlock()
+}
+
+// consuming is done once Stop is called, consumers stop taking new messages
+func (l *lifecycle) consuming() context.Context {
+	return l.current().consuming
+}
+
+// work is done once Stop gives up waiting for the messages in flight
+func (l *lifecycle) work() context.Context {
+	return l.current().work
+}
+
+// spawn runs fn as a consumer that Stop waits for
+func (l *lifecycle) spawn(fn func()) {
+	r := l.current()
+	r.wg.Add(1)
+	r.spawned.Add(1)
+	r.running.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer r.running.Add(-1)
+		fn()
+	}()
+}
+
+// alive reports whether every consumer is still running, a consumer only returns on its own when it failed
+func (l *lifecycle) alive() bool {
+	r := l.current()
+
+	return r.consuming.Err() != nil || r.running.Load() == r.spawned.Load()
+}
+
+func (l *lifecycle) stopping() bool {
+	return l.consuming().Err() != nil
+}
+
+// begin counts n messages as in flight until end is called for them
+func (l *lifecycle) begin(n int) {
+	l.inFlight.Add(int64(n))
+}
+
+func (l *lifecycle) end(n int) {
+	l.inFlight.Add(-int64(n))
+}
+
+// wait blocks a Start until Stop is called and its consumers are done
+func (l *lifecycle) wait() {
+	r := l.current()
+	<-r.consuming.Done()
+	r.wg.Wait()
+}
+
+// stop stops consuming and waits for the messages in flight until ctx is done, the error reports the messages left
+// unprocessed. Later calls return the same error until the broker is started again
+func (l *lifecycle) stop(ctx context.Context) error {
+	l.runMu.Lock()
+	defer l.runMu.Unlock()
+
+	r := l.current()
+	if r.stopped {
+		return r.stopErr
+	}
+
+	r.stopConsuming()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		r.stopErr = fmt.Errorf("%d messages are left unprocessed: %w", l.inFlight.Load(), ctx.Err())
+	}
+
+	r.abort()
+	r.stopped = true
+
+	return r.stopErr
+}
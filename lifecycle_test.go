@@ -0,0 +1,64 @@
+package milvus_cdc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLifecycleStartsANewRunAfterStop(t *testing.T) {
+	l := newLifecycle()
+
+	block := make(chan struct{})
+	l.spawn(func() {
+		<-block
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := l.stop(ctx)
+	if err == nil {
+		t.Fatalf("stop did not report the consumer left running")
+	}
+
+	if l.work().Err() == nil {
+		t.Fatalf("the work context is not cancelled once stop gave up")
+	}
+
+	// a repeated stop reports the same error
+	if again := l.stop(context.Background()); again != err {
+		t.Fatalf("the second stop returned %v, wanted %v", again, err)
+	}
+
+	close(block)
+	l.start()
+
+	if l.consuming().Err() != nil || l.work().Err() != nil {
+		t.Fatalf("the contexts of the new run are done")
+	}
+
+	if !l.alive() {
+		t.Fatalf("the new run is not alive")
+	}
+
+	l.spawn(func() {
+		<-l.consuming().Done()
+	})
+
+	err = l.stop(context.Background())
+	if err != nil {
+		t.Fatalf("stop of the new run is failed with err %v", err)
+	}
+}
+
+func TestLifecycleStartKeepsTheRunningRun(t *testing.T) {
+	l := newLifecycle()
+	before := l.current()
+
+	l.start()
+
+	if l.current() != before {
+		t.Fatalf("start replaced a run that was not stopped")
+	}
+}
@@ -1,16 +1,136 @@
 package milvus_cdc
 
-import "github.com/milvus-io/milvus-sdk-go/milvus"
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+)
 
 type MessageCDC struct {
-	Action         string            `json:"action"`
-	Vector         string            `json:"vector"`
-	CollectionName string            `json:"collection_name"`
-	PartitionTag   string            `json:"partition_tag"`
-	NList          int64             `json:"n_list"`
-	Id             int64             `json:"id"`
-	Dimension      int64             `json:"dimension"`
-	IndexFileSize  int64             `json:"index_file_size"`
-	IndexType      milvus.IndexType  `json:"index_type"`
-	MetricType     milvus.MetricType `json:"metric_type"`
+	EventId        string                 `json:"event_id,omitempty"`
+	Sequence       int64                  `json:"sequence,omitempty"`
+	TraceParent    string                 `json:"traceparent,omitempty"`
+	TraceState     string                 `json:"tracestate,omitempty"`
+	Action         string                 `json:"action"`
+	Vector         string                 `json:"vector"`
+	CollectionName string                 `json:"collection_name"`
+	PartitionTag   string                 `json:"partition_tag"`
+	NList          int64                  `json:"n_list"`
+	Id             int64                  `json:"id"`
+	Ids            []int64                `json:"ids,omitempty"`
+	Vectors        []string               `json:"vectors,omitempty"`
+	Dimension      int64                  `json:"dimension"`
+	IndexFileSize  int64                  `json:"index_file_size"`
+	IndexType      milvus.IndexType       `json:"index_type"`
+	MetricType     milvus.MetricType      `json:"metric_type"`
+	Fields         map[string]interface{} `json:"fields,omitempty"`
+	Schema         []FieldSchema          `json:"schema,omitempty"`
+}
+
+type FieldSchema struct {
+	Name      string `json:"name"`
+	DataType  string `json:"data_type"`
+	MaxLength int64  `json:"max_length,omitempty"`
+}
+
+// Entities returns the ids and vectors carried by the message, either the arrays or the single Id and Vector
+func (m *MessageCDC) Entities() ([]int64, []string) {
+	if len(m.Ids) > 0 || len(m.Vectors) > 0 {
+		return m.Ids, m.Vectors
+	}
+
+	return []int64{m.Id}, []string{m.Vector}
+}
+
+func (m *MessageCDC) Validate() error {
+	if m.Sequence < 0 {
+		return fmt.Errorf("the sequence %d is invalid", m.Sequence)
+	}
+
+	switch m.Action {
+	case Insert:
+		return m.validateInsert()
+	case Delete:
+		ids, _ := m.Entities()
+		if len(ids) == 0 {
+			return fmt.Errorf("the ids are empty")
+		}
+	case CreateCollection, DropCollection, CreatePartition, DropPartition, CreateIndex, DropIndex,
+		LoadCollection, ReleaseCollection:
+	default:
+		return fmt.Errorf("the action is invalid")
+	}
+
+	return nil
+}
+
+func (m *MessageCDC) validateInsert() error {
+	ids, vectors := m.Entities()
+	if len(ids) != len(vectors) {
+		return fmt.Errorf("the number of vectors %d does not match the number of ids %d", len(vectors), len(ids))
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("the ids are empty")
+	}
+
+	if len(ids) > 1 && len(m.Fields) > 0 {
+		return fmt.Errorf("the fields are only supported for a single entity")
+	}
+
+	dimension := m.Dimension
+	for i, vector := range vectors {
+		// every float32 is encoded as 4 bytes, so 8 hex characters
+		if len(vector) == 0 || len(vector)%8 != 0 {
+			return fmt.Errorf("the vector of id %d is invalid", ids[i])
+		}
+
+		if _, err := hex.DecodeString(vector); err != nil {
+			return fmt.Errorf("the vector of id %d is invalid: %v", ids[i], err)
+		}
+
+		if dimension == 0 {
+			dimension = int64(len(vector) / 8)
+		}
+
+		if int64(len(vector)/8) != dimension {
+			return fmt.Errorf("the dimension of id %d is %d, expected %d", ids[i], len(vector)/8, dimension)
+		}
+	}
+
+	return nil
+}
+
+// validateFields checks the fields every action needs before the message is published, Validate only checks what
+// the sync path cannot recover from
+func (m *MessageCDC) validateFields() error {
+	if m.CollectionName == "" {
+		return fmt.Errorf("the collection name is empty")
+	}
+
+	switch m.Action {
+	case CreateCollection:
+		if m.Dimension <= 0 {
+			return fmt.Errorf("the dimension %d is invalid", m.Dimension)
+		}
+
+		if m.MetricType == 0 {
+			return fmt.Errorf("the metric type is empty")
+		}
+	case CreatePartition, DropPartition:
+		if m.PartitionTag == "" {
+			return fmt.Errorf("the partition tag is empty")
+		}
+	case CreateIndex:
+		if m.IndexType == milvus.INVALID {
+			return fmt.Errorf("the index type is invalid")
+		}
+
+		if m.NList < 0 {
+			return fmt.Errorf("the n list %d is invalid", m.NList)
+		}
+	}
+
+	return m.Validate()
 }
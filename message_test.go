@@ -0,0 +1,60 @@
+package milvus_cdc
+
+import (
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestMessageCDCValidate(t *testing.T) {
+	one, two := EncodeVector([]float32{1}), EncodeVector([]float32{1, 2})
+
+	tests := []struct {
+		name    string
+		message MessageCDC
+		valid   bool
+	}{
+		{"single insert", MessageCDC{Action: Insert, Id: 1, Vector: one}, true},
+		{"multi insert", MessageCDC{Action: Insert, Ids: []int64{1, 2}, Vectors: []string{one, one}}, true},
+		{"lengths differ", MessageCDC{Action: Insert, Ids: []int64{1, 2}, Vectors: []string{one}}, false},
+		{"dimensions differ", MessageCDC{Action: Insert, Ids: []int64{1, 2}, Vectors: []string{one, two}}, false},
+		{"dimension mismatch", MessageCDC{Action: Insert, Id: 1, Vector: two, Dimension: 1}, false},
+		{"vector not hex", MessageCDC{Action: Insert, Id: 1, Vector: "zzzzzzzz"}, false},
+		{"fields of many entities", MessageCDC{Action: Insert, Ids: []int64{1, 2}, Vectors: []string{one, one},
+			Fields: map[string]interface{}{"a": 1}}, false},
+		{"delete ids only", MessageCDC{Action: Delete, Ids: []int64{1, 2}}, true},
+		{"negative sequence", MessageCDC{Action: Delete, Id: 1, Sequence: -1}, false},
+		{"unknown action", MessageCDC{Action: "upsert"}, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.message.Validate()
+			if (err == nil) != test.valid {
+				t.Fatalf("validate returns %v, wanted valid %v", err, test.valid)
+			}
+		})
+	}
+}
+
+func TestSyncerAppliesMultiEntityMessagesInOneCall(t *testing.T) {
+	target := &fakeMilvus{}
+	s := newSyncer([]IMilvusClientInterface{target}, newOptions())
+
+	vector := EncodeVector([]float32{1})
+	err := s.handle(context.Background(), payload(t, &MessageCDC{Action: Insert, CollectionName: "c", Ids: []int64{1, 2, 3},
+		Vectors: []string{vector, vector, vector}}), 0)
+	if err != nil {
+		t.Fatalf("handle insert is failed with err %v", err)
+	}
+
+	err = s.handle(context.Background(), payload(t, &MessageCDC{Action: Delete, CollectionName: "c", Ids: []int64{2, 3}}), 0)
+	if err != nil {
+		t.Fatalf("handle delete is failed with err %v", err)
+	}
+
+	calls := target.Calls()
+	if len(calls) != 2 || !slices.Equal(calls[0].Ids, []int64{1, 2, 3}) || !slices.Equal(calls[1].Ids, []int64{2, 3}) {
+		t.Fatalf("the calls are %v, wanted one insert and one delete", calls)
+	}
+}
@@ -0,0 +1,180 @@
+package milvus_cdc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Metrics holds the Prometheus collectors of the replication pipeline, a nil *Metrics records nothing so the
+// brokers and clients never check whether metrics are enabled
+type Metrics struct {
+	registry    *prometheus.Registry
+	received    *prometheus.CounterVec
+	applied     *prometheus.CounterVec
+	failed      *prometheus.CounterVec
+	filtered    *prometheus.CounterVec
+	retries     *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+	queueDepth  *prometheus.GaugeVec
+	lag         *prometheus.GaugeVec
+	deadLetters prometheus.Gauge
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		received: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "messages_received_total",
+			Help:      "Messages received for a target, per action.",
+		}, []string{"action", "target"}),
+		applied: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "messages_applied_total",
+			Help:      "Messages applied to a target, per action.",
+		}, []string{"action", "target"}),
+		failed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "messages_failed_total",
+			Help:      "Messages that failed to apply to a target after all retries, per action.",
+		}, []string{"action", "target"}),
+		filtered: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "messages_filtered_total",
+			Help:      "Messages the route of a target did not match, per action.",
+		}, []string{"action", "target"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: MetricsNamespace,
+			Name:      "retries_total",
+			Help:      "Retried applies per target.",
+		}, []string{"target"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: MetricsNamespace,
+			Name:      "milvus_rpc_duration_seconds",
+			Help:      "Latency of the Milvus RPCs per address.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"address", "rpc"}),
+		queueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "queue_depth",
+			Help:      "Messages waiting in a broker queue, a redis list, stream group, kafka or nats consumer or rabbitmq queue.",
+		}, []string{"queue"}),
+		lag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "target_lag",
+			Help:      "Messages a target lags behind the broker.",
+		}, []string{"target"}),
+		deadLetters: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: MetricsNamespace,
+			Name:      "dead_letters",
+			Help:      "Messages stored in the dead letter sink and the dead letter queue of the broker.",
+		}),
+	}
+
+	m.registry.MustRegister(m.received, m.applied, m.failed, m.filtered, m.retries, m.rpcDuration, m.queueDepth, m.lag, m.deadLetters)
+
+	return m
+}
+
+// Registry lets callers register their own collectors next to the pipeline ones
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) messageReceived(action, target string) {
+	if m == nil {
+		return
+	}
+
+	m.received.WithLabelValues(action, target).Inc()
+}
+
+func (m *Metrics) messageApplied(action, target string) {
+	if m == nil {
+		return
+	}
+
+	m.applied.WithLabelValues(action, target).Inc()
+}
+
+func (m *Metrics) messageFailed(action, target string) {
+	if m == nil {
+		return
+	}
+
+	m.failed.WithLabelValues(action, target).Inc()
+}
+
+func (m *Metrics) messageFiltered(action, target string) {
+	if m == nil {
+		return
+	}
+
+	m.filtered.WithLabelValues(action, target).Inc()
+}
+
+func (m *Metrics) retried(target string, retries int) {
+	if m == nil || retries <= 0 {
+		return
+	}
+
+	m.retries.WithLabelValues(target).Add(float64(retries))
+}
+
+// observeRPC is deferred with the start of the RPC, defer m.observeRPC(address, "Insert", time.Now())
+func (m *Metrics) observeRPC(address, rpc string, start time.Time) {
+	if m == nil {
+		return
+	}
+
+	m.rpcDuration.WithLabelValues(address, rpc).Observe(time.Since(start).Seconds())
+}
+
+func (m *Metrics) setQueueDepth(queue string, depth int64) {
+	if m == nil {
+		return
+	}
+
+	m.queueDepth.WithLabelValues(queue).Set(float64(depth))
+}
+
+func (m *Metrics) setLag(target string, lag int64) {
+	if m == nil {
+		return
+	}
+
+	m.lag.WithLabelValues(target).Set(float64(lag))
+}
+
+func (m *Metrics) setDeadLetters(count int64) {
+	if m == nil {
+		return
+	}
+
+	m.deadLetters.Set(float64(count))
+}
+
+// report runs fn at once and then every interval until ctx is done, fn refreshes the gauges that are not updated while
+// messages flow
+func report(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -3,24 +3,39 @@ package milvus_cdc
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"net"
+	"sync"
 	"time"
 
-	"github.com/milvus-io/milvus-sdk-go/milvus"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
 
 type MilvusClient struct {
-	milvus  milvus.MilvusClient
-	timeout time.Duration
+	*milvusConn
+	// ctx bounds the RPCs of a client returned by WithContext
+	ctx context.Context
 }
 
-func NewMilvusClient(host, port string, timeout time.Duration) (*MilvusClient, error) {
+// milvusConn is the connection the clients returned by WithContext share
+type milvusConn struct {
+	milvus     milvus.MilvusClient
+	timeout    time.Duration
+	address    string
+	rpcMetrics *Metrics
+	closeOnce  sync.Once
+	closeErr   error
+}
+
+func NewMilvusClient(host, port string, timeout time.Duration, opts ...MilvusOption) (*MilvusClient, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
-	opts := grpc.WithKeepaliveParams(keepalive.ClientParameters{
+	o := newMilvusOptions(opts...)
+	keepaliveOpts := grpc.WithKeepaliveParams(keepalive.ClientParameters{
 		Time:                DefaultTimeout,
 		Timeout:             DefaultTimeout,
 		PermitWithoutStream: false,
@@ -29,7 +44,8 @@ func NewMilvusClient(host, port string, timeout time.Duration) (*MilvusClient, e
 	connectParam := milvus.ConnectParam{
 		IPAddress: host,
 		Port:      port,
-		Opts:      []grpc.DialOption{opts},
+		// the sdk dials insecure first, so the transport of the options takes precedence
+		Opts: append([]grpc.DialOption{keepaliveOpts}, o.dialOptions()...),
 	}
 
 	client, err := milvus.NewMilvusClient(ctx, connectParam)
@@ -43,75 +59,112 @@ func NewMilvusClient(host, port string, timeout time.Duration) (*MilvusClient, e
 	}
 
 	return &MilvusClient{
-		milvus:  client,
-		timeout: timeout,
+		milvusConn: &milvusConn{
+			milvus:     client,
+			timeout:    timeout,
+			address:    net.JoinHostPort(host, port),
+			rpcMetrics: o.metrics,
+		},
+		ctx: context.Background(),
 	}, nil
 }
 
+// WithContext returns a client sharing the connection whose RPCs run under ctx, so the span and deadline of the caller
+// reach Milvus
+func (mc *MilvusClient) WithContext(ctx context.Context) IMilvusClientInterface {
+	return &MilvusClient{
+		milvusConn: mc.milvusConn,
+		ctx:        ctx,
+	}
+}
+
 func (mc *MilvusClient) Insert(vector, collectionName, partitionTag string, id int64) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	return mc.InsertBatch([]string{vector}, collectionName, partitionTag, []int64{id})
+}
+
+func (mc *MilvusClient) InsertBatch(vectors []string, collectionName, partitionTag string, ids []int64) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "InsertBatch", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
-	vByte, err := hex.DecodeString(vector)
-	if err != nil {
-		return err
+	if len(vectors) != len(ids) {
+		return newInvalidError("InsertBatch", fmt.Errorf("the number of vectors %d does not match the number of ids %d", len(vectors), len(ids)))
+	}
+
+	records := make([]milvus.Entity, 0, len(vectors))
+	for _, vector := range vectors {
+		vByte, err := hex.DecodeString(vector)
+		if err != nil {
+			return newInvalidError("InsertBatch", err)
+		}
+
+		records = append(records, milvus.Entity{
+			FloatData: DecodeUnsafeF32(vByte),
+		})
 	}
 
 	_, status, err := mc.milvus.Insert(ctx, &milvus.InsertParam{
 		CollectionName: collectionName,
 		PartitionTag:   partitionTag,
-		RecordArray: []milvus.Entity{
-			{
-				FloatData: DecodeUnsafeF32(vByte),
-			},
-		},
-		IDArray: []int64{id},
+		RecordArray:    records,
+		IDArray:        ids,
 	})
 	if err != nil {
-		return err
+		return newRPCError("InsertBatch", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("InsertBatch", status)
 	}
 
 	return nil
 }
 
 func (mc *MilvusClient) Delete(collectionName, partitionTag string, id int64) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	return mc.DeleteBatch(collectionName, partitionTag, []int64{id})
+}
+
+func (mc *MilvusClient) DeleteBatch(collectionName, partitionTag string, ids []int64) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "DeleteBatch", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
-	status, err := mc.milvus.DeleteEntityByID(ctx, collectionName, partitionTag, []int64{id})
+	status, err := mc.milvus.DeleteEntityByID(ctx, collectionName, partitionTag, ids)
 	if err != nil {
-		return err
+		return newRPCError("DeleteBatch", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("DeleteBatch", status)
 	}
 
 	return nil
 }
 
 func (mc *MilvusClient) DropCollection(collectionName string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	defer mc.rpcMetrics.observeRPC(mc.address, "DropCollection", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
 	status, err := mc.milvus.DropCollection(ctx, collectionName)
 	if err != nil {
-		return err
+		return newRPCError("DropCollection", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("DropCollection", status)
 	}
 
 	return nil
 }
 
 func (mc *MilvusClient) CreateCollection(collectionName string, dimension, indexSize int64, metric milvus.MetricType) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	defer mc.rpcMetrics.observeRPC(mc.address, "CreateCollection", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
 	status, err := mc.milvus.CreateCollection(ctx, milvus.CollectionParam{
@@ -122,18 +175,20 @@ func (mc *MilvusClient) CreateCollection(collectionName string, dimension, index
 	})
 
 	if err != nil {
-		return err
+		return newRPCError("CreateCollection", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("CreateCollection", status)
 	}
 
 	return nil
 }
 
 func (mc *MilvusClient) CreateIndex(collectionName string, nList int64, indexType milvus.IndexType) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	defer mc.rpcMetrics.observeRPC(mc.address, "CreateIndex", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
 	indexParam := &milvus.IndexParam{
@@ -144,34 +199,38 @@ func (mc *MilvusClient) CreateIndex(collectionName string, nList int64, indexTyp
 
 	status, err := mc.milvus.CreateIndex(ctx, indexParam)
 	if err != nil {
-		return err
+		return newRPCError("CreateIndex", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("CreateIndex", status)
 	}
 
 	return nil
 }
 
 func (mc *MilvusClient) DropIndex(collectionName string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	defer mc.rpcMetrics.observeRPC(mc.address, "DropIndex", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
 	status, err := mc.milvus.DropIndex(ctx, collectionName)
 	if err != nil {
-		return err
+		return newRPCError("DropIndex", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("DropIndex", status)
 	}
 
 	return nil
 }
 
 func (mc *MilvusClient) CreatePartition(collectionName, partitionTag string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	defer mc.rpcMetrics.observeRPC(mc.address, "CreatePartition", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
 	status, err := mc.milvus.CreatePartition(ctx, milvus.PartitionParam{
@@ -179,18 +238,20 @@ func (mc *MilvusClient) CreatePartition(collectionName, partitionTag string) err
 		PartitionTag:   partitionTag,
 	})
 	if err != nil {
-		return err
+		return newRPCError("CreatePartition", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("CreatePartition", status)
 	}
 
 	return nil
 }
 
 func (mc *MilvusClient) DropPartition(collectionName, partitionTag string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+	defer mc.rpcMetrics.observeRPC(mc.address, "DropPartition", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
 	defer cancel()
 
 	status, err := mc.milvus.DropPartition(ctx, milvus.PartitionParam{
@@ -198,12 +259,255 @@ func (mc *MilvusClient) DropPartition(collectionName, partitionTag string) error
 		PartitionTag:   partitionTag,
 	})
 	if err != nil {
-		return err
+		return newRPCError("DropPartition", err)
 	}
 
 	if !status.Ok() {
-		return fmt.Errorf("%v", status.GetMessage())
+		return newStatusError("DropPartition", status)
 	}
 
 	return nil
 }
+
+func (mc *MilvusClient) LoadCollection(collectionName string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "LoadCollection", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	status, err := mc.milvus.LoadCollection(ctx, milvus.LoadCollectionParam{
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return newRPCError("LoadCollection", err)
+	}
+
+	if !status.Ok() {
+		return newStatusError("LoadCollection", status)
+	}
+
+	return nil
+}
+
+func (mc *MilvusClient) ReleaseCollection(collectionName string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "ReleaseCollection", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	status, err := mc.milvus.ReleaseCollection(ctx, milvus.LoadCollectionParam{
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return newRPCError("ReleaseCollection", err)
+	}
+
+	if !status.Ok() {
+		return newStatusError("ReleaseCollection", status)
+	}
+
+	return nil
+}
+
+func (mc *MilvusClient) IsConnected() bool {
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	return mc.milvus.IsConnected(ctx)
+}
+
+func (mc *MilvusClient) ServerStatus() (string, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "ServerStatus", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	serverStatus, status, err := mc.milvus.ServerStatus(ctx)
+	if err != nil {
+		return "", newRPCError("ServerStatus", err)
+	}
+
+	if !status.Ok() {
+		return "", newStatusError("ServerStatus", status)
+	}
+
+	return serverStatus, nil
+}
+
+// CollectionStats is the JSON returned by ShowCollectionInfo, it lists the segments of every partition
+type CollectionStats struct {
+	RowCount   int64            `json:"row_count"`
+	Partitions []PartitionStats `json:"partitions"`
+}
+
+type PartitionStats struct {
+	Tag      string         `json:"tag"`
+	RowCount int64          `json:"row_count"`
+	Segments []SegmentStats `json:"segments"`
+}
+
+type SegmentStats struct {
+	Name      string `json:"name"`
+	RowCount  int64  `json:"row_count"`
+	IndexName string `json:"index_name"`
+	DataSize  int64  `json:"data_size"`
+}
+
+func (mc *MilvusClient) ListCollections() ([]string, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "ListCollections", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	collections, status, err := mc.milvus.ListCollections(ctx)
+	if err != nil {
+		return nil, newRPCError("ListCollections", err)
+	}
+
+	if !status.Ok() {
+		return nil, newStatusError("ListCollections", status)
+	}
+
+	return collections, nil
+}
+
+func (mc *MilvusClient) GetCollectionInfo(collectionName string) (milvus.CollectionParam, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "GetCollectionInfo", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	collection, status, err := mc.milvus.GetCollectionInfo(ctx, collectionName)
+	if err != nil {
+		return milvus.CollectionParam{}, newRPCError("GetCollectionInfo", err)
+	}
+
+	if !status.Ok() {
+		return milvus.CollectionParam{}, newStatusError("GetCollectionInfo", status)
+	}
+
+	return collection, nil
+}
+
+func (mc *MilvusClient) ListPartitions(collectionName string) ([]string, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "ListPartitions", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	partitions, status, err := mc.milvus.ListPartitions(ctx, collectionName)
+	if err != nil {
+		return nil, newRPCError("ListPartitions", err)
+	}
+
+	if !status.Ok() {
+		return nil, newStatusError("ListPartitions", status)
+	}
+
+	tags := make([]string, 0, len(partitions))
+	for _, partition := range partitions {
+		tags = append(tags, partition.PartitionTag)
+	}
+
+	return tags, nil
+}
+
+func (mc *MilvusClient) GetIndexInfo(collectionName string) (milvus.IndexParam, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "GetIndexInfo", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	index, status, err := mc.milvus.GetIndexInfo(ctx, collectionName)
+	if err != nil {
+		return milvus.IndexParam{}, newRPCError("GetIndexInfo", err)
+	}
+
+	if !status.Ok() {
+		return milvus.IndexParam{}, newStatusError("GetIndexInfo", status)
+	}
+
+	return index, nil
+}
+
+func (mc *MilvusClient) ShowCollectionInfo(collectionName string) (*CollectionStats, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "ShowCollectionInfo", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	info, status, err := mc.milvus.GetCollectionStats(ctx, collectionName)
+	if err != nil {
+		return nil, newRPCError("ShowCollectionInfo", err)
+	}
+
+	if !status.Ok() {
+		return nil, newStatusError("ShowCollectionInfo", status)
+	}
+
+	var stats CollectionStats
+
+	err = json.Unmarshal([]byte(info), &stats)
+	if err != nil {
+		return nil, newInvalidError("ShowCollectionInfo", fmt.Errorf("the collection info %v is invalid: %v", info, err))
+	}
+
+	return &stats, nil
+}
+
+func (mc *MilvusClient) ListIDInSegment(collectionName, segmentName string) ([]int64, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "ListIDInSegment", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	ids, status, err := mc.milvus.ListIDInSegment(ctx, milvus.ListIDInSegmentParam{
+		CollectionName: collectionName,
+		SegmentName:    segmentName,
+	})
+	if err != nil {
+		return nil, newRPCError("ListIDInSegment", err)
+	}
+
+	if !status.Ok() {
+		return nil, newStatusError("ListIDInSegment", status)
+	}
+
+	return ids, nil
+}
+
+// GetEntityByID returns the entities in the order of ids, the entity of a deleted id has no data
+func (mc *MilvusClient) GetEntityByID(collectionName, partitionTag string, ids []int64) ([]milvus.Entity, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "GetEntityByID", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	entities, status, err := mc.milvus.GetEntityByID(ctx, collectionName, partitionTag, ids)
+	if err != nil {
+		return nil, newRPCError("GetEntityByID", err)
+	}
+
+	if !status.Ok() {
+		return nil, newStatusError("GetEntityByID", status)
+	}
+
+	return entities, nil
+}
+
+// Address is the host and port the client is connected to
+func (mc *MilvusClient) Address() string {
+	return mc.address
+}
+
+// Close disconnects from the target, it is safe to call it from every broker sharing the client
+func (mc *MilvusClient) Close() error {
+	mc.closeOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), mc.timeout)
+		defer cancel()
+
+		mc.closeErr = mc.milvus.Disconnect(ctx)
+	})
+
+	return mc.closeErr
+}
@@ -0,0 +1,83 @@
+package milvus_cdc
+
+import (
+	"crypto/tls"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type MilvusOption func(*milvusOptions)
+
+type milvusOptions struct {
+	tls            *tls.Config
+	metrics        *Metrics
+	tracerProvider trace.TracerProvider
+	primaryField   string
+	vectorField    string
+}
+
+func newMilvusOptions(opts ...MilvusOption) *milvusOptions {
+	o := &milvusOptions{
+		primaryField: DefaultPrimaryField,
+		vectorField:  DefaultVectorField,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithTLS connects to the target over TLS with the given config instead of a plaintext connection
+func WithTLS(config *tls.Config) MilvusOption {
+	return func(o *milvusOptions) {
+		o.tls = config
+	}
+}
+
+// WithMilvusMetrics records the latency of every RPC to the target
+func WithMilvusMetrics(metrics *Metrics) MilvusOption {
+	return func(o *milvusOptions) {
+		o.metrics = metrics
+	}
+}
+
+// WithMilvusTracerProvider traces every RPC to the target with the provider instead of the global one
+func WithMilvusTracerProvider(provider trace.TracerProvider) MilvusOption {
+	return func(o *milvusOptions) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithFieldNames names the primary key and vector fields of the collections a Milvus 2.x client creates, existing
+// collections are written with the fields of their schema
+func WithFieldNames(primaryField, vectorField string) MilvusOption {
+	return func(o *milvusOptions) {
+		if primaryField != "" {
+			o.primaryField = primaryField
+		}
+
+		if vectorField != "" {
+			o.vectorField = vectorField
+		}
+	}
+}
+
+// dialOptions returns the grpc options added to the ones of the sdk, the tracing of the RPCs and the TLS transport
+// when it is set
+func (o *milvusOptions) dialOptions() []grpc.DialOption {
+	provider := o.tracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	dialOptions := []grpc.DialOption{grpc.WithChainUnaryInterceptor(tracingInterceptor(provider))}
+	if o.tls != nil {
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(credentials.NewTLS(o.tls)))
+	}
+
+	return dialOptions
+}
@@ -0,0 +1,690 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/merr"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// v2Collection is what the client reads from the schema of a collection, the metric type is empty when the collection
+// was not created by the client and has no index yet
+type v2Collection struct {
+	schema       *entity.Schema
+	primaryField string
+	vectorField  string
+	metric       entity.MetricType
+}
+
+type MilvusV2Client struct {
+	*milvusV2Conn
+	// ctx bounds the RPCs of a client returned by WithContext
+	ctx context.Context
+}
+
+// milvusV2Conn is the connection and schema cache the clients returned by WithContext share
+type milvusV2Conn struct {
+	milvus       client.Client
+	timeout      time.Duration
+	primaryField string
+	vectorField  string
+	mu           sync.RWMutex
+	collections  map[string]*v2Collection
+	address      string
+	rpcMetrics   *Metrics
+	closeOnce    sync.Once
+	closeErr     error
+}
+
+func NewMilvusV2Client(host, port string, timeout time.Duration, opts ...MilvusOption) (*MilvusV2Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	o := newMilvusOptions(opts...)
+	config := client.Config{
+		Address: net.JoinHostPort(host, port),
+	}
+
+	// custom dial options replace the defaults of the sdk, so they are kept in front of the tracing and transport
+	config.DialOptions = append(append([]grpc.DialOption{}, client.DefaultGrpcOpts...), o.dialOptions()...)
+
+	c, err := client.NewClient(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MilvusV2Client{
+		milvusV2Conn: &milvusV2Conn{
+			milvus:       c,
+			timeout:      timeout,
+			primaryField: o.primaryField,
+			vectorField:  o.vectorField,
+			collections:  make(map[string]*v2Collection),
+			address:      config.Address,
+			rpcMetrics:   o.metrics,
+		},
+		ctx: context.Background(),
+	}, nil
+}
+
+// WithContext returns a client sharing the connection whose RPCs run under ctx, so the span and deadline of the caller
+// reach Milvus
+func (mc *MilvusV2Client) WithContext(ctx context.Context) IMilvusClientInterface {
+	return &MilvusV2Client{
+		milvusV2Conn: mc.milvusV2Conn,
+		ctx:          ctx,
+	}
+}
+
+func (mc *MilvusV2Client) Insert(vector, collectionName, partitionTag string, id int64) error {
+	return mc.InsertBatchWithFields([]string{vector}, collectionName, partitionTag, []int64{id}, nil)
+}
+
+func (mc *MilvusV2Client) InsertBatch(vectors []string, collectionName, partitionTag string, ids []int64) error {
+	return mc.InsertBatchWithFields(vectors, collectionName, partitionTag, ids, nil)
+}
+
+func (mc *MilvusV2Client) InsertWithFields(vector, collectionName, partitionTag string, id int64, fields map[string]interface{}) error {
+	return mc.InsertBatchWithFields([]string{vector}, collectionName, partitionTag, []int64{id}, []map[string]interface{}{fields})
+}
+
+func (mc *MilvusV2Client) InsertBatchWithFields(vectors []string, collectionName, partitionTag string, ids []int64, fields []map[string]interface{}) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "InsertBatchWithFields", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	if len(vectors) != len(ids) {
+		return newInvalidError("InsertBatchWithFields", fmt.Errorf("the number of vectors %d does not match the number of ids %d", len(vectors), len(ids)))
+	}
+
+	if len(vectors) == 0 {
+		return newInvalidError("InsertBatchWithFields", fmt.Errorf("the vector is invalid"))
+	}
+
+	records := make([][]float32, 0, len(vectors))
+	for _, vector := range vectors {
+		vByte, err := hex.DecodeString(vector)
+		if err != nil {
+			return newInvalidError("InsertBatchWithFields", err)
+		}
+
+		if len(vByte) == 0 || len(vByte)%4 != 0 {
+			return newInvalidError("InsertBatchWithFields", fmt.Errorf("the vector is invalid"))
+		}
+
+		records = append(records, DecodeUnsafeF32(vByte))
+	}
+
+	collection, err := mc.collection(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	columns := []entity.Column{
+		entity.NewColumnInt64(collection.primaryField, ids),
+		entity.NewColumnFloatVector(collection.vectorField, len(records[0]), records),
+	}
+
+	for _, field := range collection.schema.Fields {
+		if field.Name == collection.primaryField || field.Name == collection.vectorField {
+			continue
+		}
+
+		values := make([]interface{}, 0, len(ids))
+		for i := range ids {
+			if i >= len(fields) {
+				return newInvalidError("InsertBatchWithFields", fmt.Errorf("the field %v is missing", field.Name))
+			}
+
+			value, ok := fields[i][field.Name]
+			if !ok {
+				return newInvalidError("InsertBatchWithFields", fmt.Errorf("the field %v is missing", field.Name))
+			}
+
+			values = append(values, value)
+		}
+
+		column, err := newColumn(field, values)
+		if err != nil {
+			return newInvalidError("InsertBatchWithFields", err)
+		}
+
+		columns = append(columns, column)
+	}
+
+	_, err = mc.milvus.Insert(ctx, collectionName, partitionTag, columns...)
+	if err != nil {
+		return newV2Error("InsertBatchWithFields", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) Delete(collectionName, partitionTag string, id int64) error {
+	return mc.DeleteBatch(collectionName, partitionTag, []int64{id})
+}
+
+func (mc *MilvusV2Client) DeleteBatch(collectionName, partitionTag string, ids []int64) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "DeleteBatch", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	collection, err := mc.collection(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	err = mc.milvus.DeleteByPks(ctx, collectionName, partitionTag, entity.NewColumnInt64(collection.primaryField, ids))
+	if err != nil {
+		return newV2Error("DeleteBatch", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) DropCollection(collectionName string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "DropCollection", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	err := mc.milvus.DropCollection(ctx, collectionName)
+	if err != nil {
+		return newV2Error("DropCollection", err)
+	}
+
+	mc.mu.Lock()
+	delete(mc.collections, collectionName)
+	mc.mu.Unlock()
+
+	return nil
+}
+
+func (mc *MilvusV2Client) CreateCollection(collectionName string, dimension, _ int64, metric milvus.MetricType) error {
+	return mc.CreateCollectionWithSchema(collectionName, dimension, metric, nil)
+}
+
+func (mc *MilvusV2Client) CreateCollectionWithSchema(collectionName string, dimension int64, metric milvus.MetricType, fields []FieldSchema) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "CreateCollectionWithSchema", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	schema := entity.NewSchema().WithName(collectionName).
+		WithField(entity.NewField().WithName(mc.primaryField).WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName(mc.vectorField).WithDataType(entity.FieldTypeFloatVector).WithDim(dimension))
+
+	for _, field := range fields {
+		dataType, err := fieldType(field.DataType)
+		if err != nil {
+			return newInvalidError("CreateCollectionWithSchema", err)
+		}
+
+		f := entity.NewField().WithName(field.Name).WithDataType(dataType)
+		if dataType == entity.FieldTypeVarChar {
+			maxLength := field.MaxLength
+			if maxLength <= 0 {
+				maxLength = DefaultMaxLength
+			}
+
+			f = f.WithMaxLength(maxLength)
+		}
+
+		schema = schema.WithField(f)
+	}
+
+	// milvus 2.x keeps the metric type in the index only, the property lets CreateIndex read it after a restart
+	err := mc.milvus.CreateCollection(ctx, schema, DefaultShardsNum,
+		client.WithCollectionProperty(MetricTypeProperty, string(metricType(metric))))
+	if err != nil {
+		return newV2Error("CreateCollectionWithSchema", err)
+	}
+
+	mc.mu.Lock()
+	mc.collections[collectionName] = &v2Collection{
+		schema:       schema,
+		primaryField: mc.primaryField,
+		vectorField:  mc.vectorField,
+		metric:       metricType(metric),
+	}
+	mc.mu.Unlock()
+
+	return nil
+}
+
+func (mc *MilvusV2Client) CreateIndex(collectionName string, nList int64, indexType milvus.IndexType) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "CreateIndex", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	collection, err := mc.collection(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	metric, err := mc.metricType(ctx, collection, collectionName)
+	if err != nil {
+		return err
+	}
+
+	index, err := newIndex(indexType, metric, int(nList))
+	if err != nil {
+		return newInvalidError("CreateIndex", err)
+	}
+
+	err = mc.milvus.CreateIndex(ctx, collectionName, collection.vectorField, index, false)
+	if err != nil {
+		return newV2Error("CreateIndex", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) DropIndex(collectionName string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "DropIndex", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	collection, err := mc.collection(ctx, collectionName)
+	if err != nil {
+		return err
+	}
+
+	err = mc.milvus.DropIndex(ctx, collectionName, collection.vectorField)
+	if err != nil {
+		return newV2Error("DropIndex", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) CreatePartition(collectionName, partitionTag string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "CreatePartition", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	err := mc.milvus.CreatePartition(ctx, collectionName, partitionTag)
+	if err != nil {
+		return newV2Error("CreatePartition", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) DropPartition(collectionName, partitionTag string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "DropPartition", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	err := mc.milvus.DropPartition(ctx, collectionName, partitionTag)
+	if err != nil {
+		return newV2Error("DropPartition", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) LoadCollection(collectionName string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "LoadCollection", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	err := mc.milvus.LoadCollection(ctx, collectionName, false)
+	if err != nil {
+		return newV2Error("LoadCollection", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) ReleaseCollection(collectionName string) error {
+	defer mc.rpcMetrics.observeRPC(mc.address, "ReleaseCollection", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	err := mc.milvus.ReleaseCollection(ctx, collectionName)
+	if err != nil {
+		return newV2Error("ReleaseCollection", err)
+	}
+
+	return nil
+}
+
+func (mc *MilvusV2Client) IsConnected() bool {
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	state, err := mc.milvus.CheckHealth(ctx)
+	if err != nil {
+		return false
+	}
+
+	return state.IsHealthy
+}
+
+func (mc *MilvusV2Client) ServerStatus() (string, error) {
+	defer mc.rpcMetrics.observeRPC(mc.address, "CheckHealth", time.Now())
+
+	ctx, cancel := context.WithTimeout(mc.ctx, mc.timeout)
+	defer cancel()
+
+	state, err := mc.milvus.CheckHealth(ctx)
+	if err != nil {
+		return "", newV2Error("CheckHealth", err)
+	}
+
+	if !state.IsHealthy {
+		return "", &MilvusError{
+			Op:    "CheckHealth",
+			Class: ErrorClassTransient,
+			Err:   fmt.Errorf("milvus is unhealthy: %v", strings.Join(state.Reasons, ", ")),
+		}
+	}
+
+	return "healthy", nil
+}
+
+// Address is the host and port the client is connected to
+func (mc *MilvusV2Client) Address() string {
+	return mc.address
+}
+
+// Close disconnects from the target, it is safe to call it from every broker sharing the client
+func (mc *MilvusV2Client) Close() error {
+	mc.closeOnce.Do(func() {
+		mc.closeErr = mc.milvus.Close()
+	})
+
+	return mc.closeErr
+}
+
+// collection describes the collection once and takes the primary and vector fields from its schema
+func (mc *MilvusV2Client) collection(ctx context.Context, collectionName string) (*v2Collection, error) {
+	mc.mu.RLock()
+	collection, ok := mc.collections[collectionName]
+	mc.mu.RUnlock()
+
+	if ok {
+		return collection, nil
+	}
+
+	described, err := mc.milvus.DescribeCollection(ctx, collectionName)
+	if err != nil {
+		return nil, newV2Error("DescribeCollection", err)
+	}
+
+	collection = &v2Collection{
+		schema: described.Schema,
+		metric: entity.MetricType(described.Properties[MetricTypeProperty]),
+	}
+
+	for _, field := range described.Schema.Fields {
+		if field.PrimaryKey {
+			collection.primaryField = field.Name
+		}
+
+		if field.DataType == entity.FieldTypeFloatVector && collection.vectorField == "" {
+			collection.vectorField = field.Name
+		}
+	}
+
+	if collection.primaryField == "" || collection.vectorField == "" {
+		return nil, newInvalidError("DescribeCollection", fmt.Errorf("the collection %v has no primary key or float vector field", collectionName))
+	}
+
+	mc.mu.Lock()
+	mc.collections[collectionName] = collection
+	mc.mu.Unlock()
+
+	return collection, nil
+}
+
+// metricType reads the metric of a collection that was not created by the client from its current index
+func (mc *MilvusV2Client) metricType(ctx context.Context, collection *v2Collection, collectionName string) (entity.MetricType, error) {
+	if collection.metric != "" {
+		return collection.metric, nil
+	}
+
+	indexes, err := mc.milvus.DescribeIndex(ctx, collectionName, collection.vectorField)
+	if err != nil {
+		return "", newV2Error("DescribeIndex", err)
+	}
+
+	for _, index := range indexes {
+		if metric := index.Params()["metric_type"]; metric != "" {
+			return entity.MetricType(metric), nil
+		}
+	}
+
+	return "", newInvalidError("CreateIndex", fmt.Errorf("the metric type of collection %v is unknown", collectionName))
+}
+
+func fieldType(dataType string) (entity.FieldType, error) {
+	switch dataType {
+	case Bool:
+		return entity.FieldTypeBool, nil
+	case Int8:
+		return entity.FieldTypeInt8, nil
+	case Int16:
+		return entity.FieldTypeInt16, nil
+	case Int32:
+		return entity.FieldTypeInt32, nil
+	case Int64:
+		return entity.FieldTypeInt64, nil
+	case Float:
+		return entity.FieldTypeFloat, nil
+	case Double:
+		return entity.FieldTypeDouble, nil
+	case VarChar:
+		return entity.FieldTypeVarChar, nil
+	case JSON:
+		return entity.FieldTypeJSON, nil
+	}
+
+	return entity.FieldTypeNone, fmt.Errorf("the data type %v is invalid", dataType)
+}
+
+// newColumn converts values decoded from the message json, where numbers are kept as json.Number so int64 values
+// larger than 2^53 are not rounded, to a column
+func newColumn(field *entity.Field, values []interface{}) (entity.Column, error) {
+	switch field.DataType {
+	case entity.FieldTypeBool:
+		data, err := convert(field, values, func(v interface{}) (bool, bool) {
+			b, ok := v.(bool)
+			return b, ok
+		})
+		return entity.NewColumnBool(field.Name, data), err
+	case entity.FieldTypeInt8:
+		data, err := convert(field, values, toInt[int8])
+		return entity.NewColumnInt8(field.Name, data), err
+	case entity.FieldTypeInt16:
+		data, err := convert(field, values, toInt[int16])
+		return entity.NewColumnInt16(field.Name, data), err
+	case entity.FieldTypeInt32:
+		data, err := convert(field, values, toInt[int32])
+		return entity.NewColumnInt32(field.Name, data), err
+	case entity.FieldTypeInt64:
+		data, err := convert(field, values, toInt[int64])
+		return entity.NewColumnInt64(field.Name, data), err
+	case entity.FieldTypeFloat:
+		data, err := convert(field, values, func(v interface{}) (float32, bool) {
+			f, ok := toFloat(v)
+			return float32(f), ok
+		})
+		return entity.NewColumnFloat(field.Name, data), err
+	case entity.FieldTypeDouble:
+		data, err := convert(field, values, toFloat)
+		return entity.NewColumnDouble(field.Name, data), err
+	case entity.FieldTypeVarChar, entity.FieldTypeString:
+		data, err := convert(field, values, func(v interface{}) (string, bool) {
+			str, ok := v.(string)
+			return str, ok
+		})
+		return entity.NewColumnVarChar(field.Name, data), err
+	case entity.FieldTypeJSON:
+		data := make([][]byte, 0, len(values))
+		for _, value := range values {
+			v, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+
+			data = append(data, v)
+		}
+
+		return entity.NewColumnJSONBytes(field.Name, data), nil
+	}
+
+	return nil, fmt.Errorf("the data type of field %v is not supported", field.Name)
+}
+
+func convert[T any](field *entity.Field, values []interface{}, fn func(interface{}) (T, bool)) ([]T, error) {
+	data := make([]T, 0, len(values))
+	for _, value := range values {
+		v, ok := fn(value)
+		if !ok {
+			return nil, fmt.Errorf("the value %v of field %v is invalid", value, field.Name)
+		}
+
+		data = append(data, v)
+	}
+
+	return data, nil
+}
+
+// toInt accepts the integers that fit T, a fraction or an overflow is invalid
+func toInt[T int8 | int16 | int32 | int64](value interface{}) (T, bool) {
+	var v int64
+	switch n := value.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, false
+		}
+
+		v = i
+	case float64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 {
+			return 0, false
+		}
+
+		v = int64(n)
+	case int:
+		v = int64(n)
+	case int8:
+		v = int64(n)
+	case int16:
+		v = int64(n)
+	case int32:
+		v = int64(n)
+	case int64:
+		v = n
+	default:
+		return 0, false
+	}
+
+	if int64(T(v)) != v {
+		return 0, false
+	}
+
+	return T(v), true
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch n := value.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	}
+
+	return 0, false
+}
+
+func metricType(metric milvus.MetricType) entity.MetricType {
+	switch metric {
+	case milvus.IP:
+		return entity.IP
+	case milvus.HAMMING:
+		return entity.HAMMING
+	case milvus.JACCARD:
+		return entity.JACCARD
+	case milvus.TANIMOTO:
+		return entity.TANIMOTO
+	case milvus.SUBSTRUCTURE:
+		return entity.SUBSTRUCTURE
+	case milvus.SUPERSTRUCTURE:
+		return entity.SUPERSTRUCTURE
+	}
+
+	return entity.L2
+}
+
+func newIndex(indexType milvus.IndexType, metric entity.MetricType, nList int) (entity.Index, error) {
+	switch indexType {
+	case milvus.FLAT:
+		return entity.NewIndexFlat(metric)
+	case milvus.IVFFLAT:
+		return entity.NewIndexIvfFlat(metric, nList)
+	case milvus.IVFSQ8, milvus.IVFSQ8H:
+		return entity.NewIndexIvfSQ8(metric, nList)
+	case milvus.HNSW:
+		return entity.NewIndexHNSW(metric, DefaultHNSWM, DefaultEfConstruction)
+	}
+
+	return entity.NewIndexAUTOINDEX(metric)
+}
+
+func newV2Error(op string, err error) error {
+	return &MilvusError{
+		Op:    op,
+		Class: classifyV2(err),
+		Err:   err,
+	}
+}
+
+// classifyV2 classifies the errors of the v2 sdk by their gRPC status, their merr code or their reason, an unknown
+// error is permanent so it is not retried forever
+func classifyV2(err error) ErrorClass {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ErrorClassTransient
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return classifyRPC(err)
+	}
+
+	if merr.IsRetryableErr(err) {
+		return ErrorClassTransient
+	}
+
+	return classifyReason(err.Error())
+}
@@ -0,0 +1,365 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus-sdk-go/v2/entity"
+	"github.com/milvus-io/milvus-sdk-go/v2/merr"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// milvusV2Stub is a Milvus 2.x gRPC stand-in serving one collection named c with the fields pk, emb and count
+type milvusV2Stub struct {
+	milvuspb.UnimplementedMilvusServiceServer
+
+	mu         sync.Mutex
+	properties map[string]string
+	indexes    []*milvuspb.IndexDescription
+	inserts    []*milvuspb.InsertRequest
+	deletes    []*milvuspb.DeleteRequest
+	created    []*milvuspb.CreateIndexRequest
+	// insertStatus is the status of every insert when it is set
+	insertStatus *commonpb.Status
+	// traceParents are the traceparent headers of the inserts
+	traceParents []string
+}
+
+func (s *milvusV2Stub) HasCollection(_ context.Context, req *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	return &milvuspb.BoolResponse{Status: &commonpb.Status{}, Value: req.GetCollectionName() == "c"}, nil
+}
+
+func (s *milvusV2Stub) DescribeCollection(_ context.Context, _ *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return &milvuspb.DescribeCollectionResponse{
+		Status: &commonpb.Status{},
+		Schema: &schemapb.CollectionSchema{
+			Name: "c",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "emb", DataType: schemapb.DataType_FloatVector,
+					TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "1"}}},
+				{FieldID: 102, Name: "count", DataType: schemapb.DataType_Int64},
+			},
+		},
+		Properties: entity.MapKvPairs(s.properties),
+	}, nil
+}
+
+func (s *milvusV2Stub) Insert(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inserts = append(s.inserts, req)
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.traceParents = append(s.traceParents, md.Get(TraceParentHeader)...)
+
+	if s.insertStatus != nil {
+		return &milvuspb.MutationResult{Status: s.insertStatus}, nil
+	}
+
+	return &milvuspb.MutationResult{
+		Status: &commonpb.Status{},
+		IDs:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+	}, nil
+}
+
+func (s *milvusV2Stub) Delete(_ context.Context, req *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deletes = append(s.deletes, req)
+
+	return &milvuspb.MutationResult{Status: &commonpb.Status{}}, nil
+}
+
+func (s *milvusV2Stub) CreateIndex(_ context.Context, req *milvuspb.CreateIndexRequest) (*commonpb.Status, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.created = append(s.created, req)
+	s.indexes = []*milvuspb.IndexDescription{{
+		FieldName: req.GetFieldName(),
+		Params:    req.GetExtraParams(),
+		State:     commonpb.IndexState_Finished,
+	}}
+
+	return &commonpb.Status{}, nil
+}
+
+func (s *milvusV2Stub) DescribeIndex(_ context.Context, _ *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.indexes) == 0 {
+		return &milvuspb.DescribeIndexResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_IndexNotExist, Reason: "index not found"},
+		}, nil
+	}
+
+	return &milvuspb.DescribeIndexResponse{Status: &commonpb.Status{}, IndexDescriptions: s.indexes}, nil
+}
+
+// newMilvusV2Stub serves the stub on a local port and connects a client to it
+func newMilvusV2Stub(t *testing.T, stub *milvusV2Stub, opts ...MilvusOption) *MilvusV2Client {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen is failed with err %v", err)
+	}
+
+	server := grpc.NewServer()
+	milvuspb.RegisterMilvusServiceServer(server, stub)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	client, err := NewMilvusV2Client(host, port, 5*time.Second, opts...)
+	if err != nil {
+		t.Fatalf("new milvus v2 client is failed with err %v", err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+
+	return client
+}
+
+func TestMilvusV2ClientWritesTheFieldsOfTheSchema(t *testing.T) {
+	stub := &milvusV2Stub{}
+	client := newMilvusV2Stub(t, stub)
+
+	// 2^53 + 1 cannot be represented by a float64
+	message, err := (&syncer{}).decode(`{"action":"insert","collection_name":"c","id":1,"vector":"` +
+		EncodeVector([]float32{1}) + `","fields":{"count":9007199254740993}}`)
+	if err != nil {
+		t.Fatalf("decode is failed with err %v", err)
+	}
+
+	err = client.InsertWithFields(message.Vector, "c", "", message.Id, message.Fields)
+	if err != nil {
+		t.Fatalf("insert is failed with err %v", err)
+	}
+
+	err = client.Delete("c", "", 1)
+	if err != nil {
+		t.Fatalf("delete is failed with err %v", err)
+	}
+
+	fields := make(map[string]*schemapb.FieldData)
+	for _, field := range stub.inserts[0].GetFieldsData() {
+		fields[field.GetFieldName()] = field
+	}
+
+	if fields["pk"].GetScalars().GetLongData().GetData()[0] != 1 || fields["emb"] == nil {
+		t.Fatalf("the primary key and vector are not written to the fields of the schema: %v", fields)
+	}
+
+	if count := fields["count"].GetScalars().GetLongData().GetData()[0]; count != 9007199254740993 {
+		t.Fatalf("the count is written as %v", count)
+	}
+
+	if expr := stub.deletes[0].GetExpr(); !strings.HasPrefix(expr, "pk in") {
+		t.Fatalf("the delete expression is %v", expr)
+	}
+}
+
+func TestMilvusV2ClientCreateIndexReadsTheMetric(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]string
+		indexes    []*milvuspb.IndexDescription
+		metric     string
+	}{
+		{
+			name:       "collection property",
+			properties: map[string]string{MetricTypeProperty: "IP"},
+			metric:     "IP",
+		},
+		{
+			name: "current index",
+			indexes: []*milvuspb.IndexDescription{{
+				FieldName: "emb",
+				Params:    []*commonpb.KeyValuePair{{Key: "metric_type", Value: "COSINE"}},
+				State:     commonpb.IndexState_Finished,
+			}},
+			metric: "COSINE",
+		},
+		{
+			name: "unknown",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stub := &milvusV2Stub{properties: test.properties, indexes: test.indexes}
+			client := newMilvusV2Stub(t, stub)
+
+			err := client.CreateIndex("c", 128, milvus.IVFFLAT)
+			if test.metric == "" {
+				if err == nil || len(stub.created) > 0 {
+					t.Fatalf("an index is created with an unknown metric")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("create index is failed with err %v", err)
+			}
+
+			params := entity.KvPairsMap(stub.created[0].GetExtraParams())
+			if params["metric_type"] != test.metric || stub.created[0].GetFieldName() != "emb" {
+				t.Fatalf("the index is created on %v with params %v", stub.created[0].GetFieldName(), params)
+			}
+		})
+	}
+}
+
+func TestNewColumnConvertsNumbers(t *testing.T) {
+	field := &entity.Field{Name: "small", DataType: entity.FieldTypeInt8}
+
+	_, err := newColumn(field, []interface{}{json.Number("300")})
+	if err == nil {
+		t.Fatalf("an int8 overflow is accepted")
+	}
+
+	_, err = newColumn(field, []interface{}{json.Number("1.5")})
+	if err == nil {
+		t.Fatalf("a fraction is accepted as an integer")
+	}
+
+	column, err := newColumn(&entity.Field{Name: "ratio", DataType: entity.FieldTypeDouble}, []interface{}{json.Number("0.25"), 3.0})
+	if err != nil {
+		t.Fatalf("new double column is failed with err %v", err)
+	}
+
+	if data := column.(*entity.ColumnDouble).Data(); data[0] != 0.25 || data[1] != 3 {
+		t.Fatalf("the double column is %v", data)
+	}
+}
+
+func TestClassifyV2(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		class ErrorClass
+	}{
+		{name: "deadline", err: context.DeadlineExceeded, class: ErrorClassTransient},
+		{name: "grpc unavailable", err: status.Error(codes.Unavailable, "connection refused"), class: ErrorClassTransient},
+		{name: "grpc invalid argument", err: status.Error(codes.InvalidArgument, "bad"), class: ErrorClassPermanent},
+		{name: "retriable merr", err: merr.ErrServiceRateLimit, class: ErrorClassTransient},
+		{name: "merr", err: merr.ErrCollectionNotFound, class: ErrorClassPermanent},
+		{name: "transient reason", err: errors.New("proxy: service unavailable"), class: ErrorClassTransient},
+		{name: "unknown", err: errors.New("collection c does not exist"), class: ErrorClassPermanent},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if class := classifyV2(test.err); class != test.class {
+				t.Fatalf("the error %v is classified %v, wanted %v", test.err, class, test.class)
+			}
+		})
+	}
+}
+
+func TestMilvusV2ClientClassifiesFailedStatuses(t *testing.T) {
+	stub := &milvusV2Stub{insertStatus: &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Code:      2,
+		Reason:    "service unavailable",
+		Retriable: true,
+	}}
+	client := newMilvusV2Stub(t, stub)
+
+	fields := map[string]interface{}{"count": 1}
+	err := client.InsertWithFields(EncodeVector([]float32{1}), "c", "", 1, fields)
+	if err == nil || !IsRetryable(err) {
+		t.Fatalf("the unavailable insert is failed with err %v, wanted a transient error", err)
+	}
+
+	stub.mu.Lock()
+	stub.insertStatus = &commonpb.Status{ErrorCode: commonpb.ErrorCode_IllegalArgument, Code: 1100, Reason: "invalid parameter"}
+	stub.mu.Unlock()
+
+	err = client.InsertWithFields(EncodeVector([]float32{1}), "c", "", 1, fields)
+	if err == nil || IsRetryable(err) {
+		t.Fatalf("the invalid insert is failed with err %v, wanted a permanent error", err)
+	}
+}
+
+func TestMilvusV2ClientPropagatesTheTraceOfTheCaller(t *testing.T) {
+	stub := &milvusV2Stub{}
+	provider := newRecordingProvider()
+	client := newMilvusV2Stub(t, stub, WithMilvusTracerProvider(provider))
+
+	parent := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0xca, 0xfe},
+		SpanID:     trace.SpanID{0xbe, 0xef},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), parent)
+
+	fields := map[string]interface{}{"count": 1}
+	err := client.WithContext(ctx).(*MilvusV2Client).InsertWithFields(EncodeVector([]float32{1}), "c", "", 1, fields)
+	if err != nil {
+		t.Fatalf("insert is failed with err %v", err)
+	}
+
+	if !slices.Contains(provider.tracer.Names(), "/milvus.proto.milvus.MilvusService/Insert") {
+		t.Fatalf("the insert rpc has no span, the spans are %v", provider.tracer.Names())
+	}
+
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+
+	// the rpc span continues the trace of the caller and is the parent Milvus sees
+	if len(stub.traceParents) != 1 || !strings.HasPrefix(stub.traceParents[0], "00-"+parent.TraceID().String()+"-") ||
+		strings.Contains(stub.traceParents[0], parent.SpanID().String()) {
+		t.Fatalf("milvus received the traceparents %v, wanted a child of %v", stub.traceParents, parent.TraceID())
+	}
+}
+
+func TestMilvusV2ClientWithContextSharesTheConnection(t *testing.T) {
+	client := newMilvusV2Stub(t, &milvusV2Stub{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fields := map[string]interface{}{"count": 1}
+	bound := client.WithContext(ctx).(*MilvusV2Client)
+	err := bound.InsertWithFields(EncodeVector([]float32{1}), "c", "", 1, fields)
+	if err == nil {
+		t.Fatalf("an insert under a cancelled context is applied")
+	}
+
+	// the cancelled context only bounds the client it was given to
+	err = client.InsertWithFields(EncodeVector([]float32{1}), "c", "", 2, fields)
+	if err != nil {
+		t.Fatalf("insert is failed with err %v", err)
+	}
+
+	if bound.milvusV2Conn != client.milvusV2Conn {
+		t.Fatalf("the bound client has its own connection")
+	}
+}
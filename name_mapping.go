@@ -0,0 +1,89 @@
+package milvus_cdc
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// NameMapping renames the collections and partitions of the messages applied to a target. The static maps are looked
+// up first, then the first rule whose glob matches the name applies its template. A name nothing matches is kept, as
+// is the default partition, so the zero NameMapping renames nothing
+type NameMapping struct {
+	Collections     map[string]string `json:"collections,omitempty"`
+	Partitions      map[string]string `json:"partitions,omitempty"`
+	CollectionRules []NameRule        `json:"collection_rules,omitempty"`
+	PartitionRules  []NameRule        `json:"partition_rules,omitempty"`
+}
+
+// NameRule renames the names matching the glob Match, in the syntax of path.Match, to Template where {collection} and
+// {partition} are replaced by the collection name and the partition tag of the message
+type NameRule struct {
+	Match    string `json:"match"`
+	Template string `json:"template"`
+}
+
+// Collection returns the name of the collection on the target
+func (m NameMapping) Collection(collectionName string) string {
+	if name, ok := m.Collections[collectionName]; ok {
+		return name
+	}
+
+	return applyNameRules(m.CollectionRules, collectionName, collectionName, "")
+}
+
+// Partition returns the tag of the partition on the target, collectionName is the name of the source
+func (m NameMapping) Partition(collectionName, partitionTag string) string {
+	if partitionTag == "" || partitionTag == DefaultPartitionTag {
+		return partitionTag
+	}
+
+	if tag, ok := m.Partitions[partitionTag]; ok {
+		return tag
+	}
+
+	return applyNameRules(m.PartitionRules, partitionTag, collectionName, partitionTag)
+}
+
+// Validate reports the globs that are malformed and the rules without template
+func (m NameMapping) Validate() error {
+	return errors.Join(append(validateNameRules("collection_rules", m.CollectionRules),
+		validateNameRules("partition_rules", m.PartitionRules)...)...)
+}
+
+// apply returns a copy of the message with the names of the target, the message itself is shared by every target
+func (m NameMapping) apply(message *MessageCDC) *MessageCDC {
+	mapped := *message
+	mapped.CollectionName = m.Collection(message.CollectionName)
+	mapped.PartitionTag = m.Partition(message.CollectionName, message.PartitionTag)
+
+	return &mapped
+}
+
+func applyNameRules(rules []NameRule, name, collectionName, partitionTag string) string {
+	for _, rule := range rules {
+		matched, _ := path.Match(rule.Match, name)
+		if matched {
+			return strings.NewReplacer("{collection}", collectionName, "{partition}", partitionTag).Replace(rule.Template)
+		}
+	}
+
+	return name
+}
+
+func validateNameRules(field string, rules []NameRule) []error {
+	var errs []error
+	for i, rule := range rules {
+		_, err := path.Match(rule.Match, "")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s[%d] glob %q is invalid", field, i, rule.Match))
+		}
+
+		if rule.Template == "" {
+			errs = append(errs, fmt.Errorf("%s[%d] template is empty", field, i))
+		}
+	}
+
+	return errs
+}
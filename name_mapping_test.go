@@ -0,0 +1,102 @@
+package milvus_cdc
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestNameMappingRenamesCollectionsAndPartitions(t *testing.T) {
+	mapping := NameMapping{
+		Collections:     map[string]string{"legacy": "staging_legacy_v2"},
+		Partitions:      map[string]string{"hot": "fast"},
+		CollectionRules: []NameRule{{Match: "tenant_*", Template: "staging_{collection}"}},
+		PartitionRules:  []NameRule{{Match: "*", Template: "{collection}_{partition}"}},
+	}
+
+	for name, want := range map[string]string{
+		"legacy":   "staging_legacy_v2",
+		"tenant_a": "staging_tenant_a",
+		"shared":   "shared",
+	} {
+		if got := mapping.Collection(name); got != want {
+			t.Errorf("the collection %v is mapped to %v, wanted %v", name, got, want)
+		}
+	}
+
+	for tag, want := range map[string]string{
+		"":                  "",
+		DefaultPartitionTag: DefaultPartitionTag,
+		"hot":               "fast",
+		"cold":              "tenant_a_cold",
+	} {
+		if got := mapping.Partition("tenant_a", tag); got != want {
+			t.Errorf("the partition %q is mapped to %q, wanted %q", tag, got, want)
+		}
+	}
+}
+
+func TestNameMappingValidate(t *testing.T) {
+	err := NameMapping{
+		CollectionRules: []NameRule{{Match: "[", Template: "x"}},
+		PartitionRules:  []NameRule{{Match: "*"}},
+	}.Validate()
+	if err == nil {
+		t.Fatalf("an invalid mapping is valid")
+	}
+
+	for _, want := range []string{`collection_rules[0] glob "[" is invalid`, "partition_rules[0] template is empty"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("the error does not report %q:\n%v", want, err)
+		}
+	}
+}
+
+func TestSyncBatchMapsTheNamesOfDDLAndDML(t *testing.T) {
+	target := &fakeMilvus{}
+	s := newSyncer([]IMilvusClientInterface{target}, newOptions(
+		// the route selects the names of the messages, not the mapped ones
+		WithRoute(0, Route{Include: []RouteRule{{Collections: []string{"c"}}}}),
+		WithNameMapping(0, NameMapping{
+			CollectionRules: []NameRule{{Match: "*", Template: "staging_{collection}"}},
+			PartitionRules:  []NameRule{{Match: "*", Template: "{partition}_v2"}},
+		})))
+
+	messages := []*MessageCDC{
+		{Action: CreateCollection, CollectionName: "c", Dimension: 1},
+		{Action: CreatePartition, CollectionName: "c", PartitionTag: "p"},
+		{Action: Insert, CollectionName: "c", PartitionTag: "p", Id: 1, Vector: EncodeVector([]float32{1})},
+		{Action: Insert, CollectionName: "c", PartitionTag: "p", Id: 2, Vector: EncodeVector([]float32{2})},
+		{Action: Delete, CollectionName: "c", Id: 1},
+	}
+
+	for i, err := range s.syncBatch(context.Background(), messages, 0) {
+		if err != nil {
+			t.Fatalf("the message %d is failed with err %v", i, err)
+		}
+	}
+
+	want := []fakeCall{
+		{Op: CreateCollection, CollectionName: "staging_c"},
+		{Op: CreatePartition, CollectionName: "staging_c", PartitionTag: "p_v2"},
+		{Op: Insert, CollectionName: "staging_c", PartitionTag: "p_v2"},
+		{Op: Insert, CollectionName: "staging_c", PartitionTag: "p_v2"},
+		{Op: Delete, CollectionName: "staging_c"},
+	}
+
+	calls := target.Calls()
+	if len(calls) != len(want) {
+		t.Fatalf("the target received %+v", calls)
+	}
+
+	for i, call := range calls {
+		if call.Op != want[i].Op || call.CollectionName != want[i].CollectionName || call.PartitionTag != want[i].PartitionTag {
+			t.Fatalf("the call %d is %+v, wanted %+v", i, call, want[i])
+		}
+	}
+
+	// the messages keep their names for the other targets
+	if messages[2].CollectionName != "c" || messages[2].PartitionTag != "p" {
+		t.Fatalf("the message is renamed to %v/%v", messages[2].CollectionName, messages[2].PartitionTag)
+	}
+}
@@ -0,0 +1,277 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+	"github.com/sirupsen/logrus"
+)
+
+type NatsBroker struct {
+	conn      *nats.Conn
+	syncer    *syncer
+	opts      *options
+	lifecycle *lifecycle
+	mu        sync.Mutex
+	consumers []natsConsumer
+}
+
+// natsConsumer is a durable consumer of the running broker and the targets it feeds
+type natsConsumer struct {
+	queue      string
+	subscriber *nats.Subscription
+	targets    []int
+}
+
+func NewNatsBroker(conn *nats.Conn, milvus []IMilvusClientInterface, opts ...Option) *NatsBroker {
+	o := newOptions(opts...)
+
+	nb := &NatsBroker{
+		conn:   conn,
+		syncer: newSyncer(milvus, o),
+		opts:   o,
+	}
+
+	nb.lifecycle = newLifecycle()
+
+	return nb
+}
+
+func (nb *NatsBroker) Start(channel, pattern string) error {
+	nb.lifecycle.start()
+
+	nb.mu.Lock()
+	nb.consumers = nil
+	nb.mu.Unlock()
+
+	switch pattern {
+	case PubSub:
+		// every target gets its own durable consumer so each of them receives all messages of the subject and keeps
+		// its position across restarts
+		subscribers := make([]*nats.Subscription, 0, len(nb.syncer.targets()))
+		durables := make([]string, 0, len(nb.syncer.targets()))
+		for _, target := range nb.syncer.targets() {
+			durable := durableName(fmt.Sprintf("%s-%s", nb.opts.consumerGroup, nb.syncer.targetId(target)))
+			subscriber, err := nb.subscribe(channel, durable)
+			if err != nil {
+				for _, subscriber := range subscribers {
+					_ = subscriber.Unsubscribe()
+				}
+
+				return err
+			}
+
+			subscribers = append(subscribers, subscriber)
+			durables = append(durables, durable)
+		}
+
+		for target, subscriber := range subscribers {
+			nb.consume(channel, durables[target], subscriber, []int{target})
+		}
+	case Queue:
+		durable := durableName(nb.opts.consumerGroup)
+		subscriber, err := nb.subscribe(channel, durable)
+		if err != nil {
+			return err
+		}
+
+		nb.consume(channel, durable, subscriber, nb.syncer.targets())
+	default:
+		return fmt.Errorf("pattern is invalid")
+	}
+
+	if nb.opts.metrics != nil {
+		nb.lifecycle.spawn(func() {
+			report(nb.lifecycle.consuming(), nb.opts.metricsPeriod, nb.refreshGauges)
+		})
+	}
+
+	nb.lifecycle.wait()
+
+	return nil
+}
+
+// Stop stops fetching new messages and waits until ctx is done for the messages in flight, the connections stay open
+// for the caller to close or to Start again. Messages left unprocessed are not acknowledged so the server redelivers them after a restart
+func (nb *NatsBroker) Stop(ctx context.Context) error {
+	return nb.lifecycle.stop(ctx)
+}
+
+func (nb *NatsBroker) Health(ctx context.Context) BrokerHealth {
+	health := BrokerHealth{
+		Broker:  Nats,
+		Alive:   nb.lifecycle.alive(),
+		Targets: nb.syncer.health(ctx),
+	}
+
+	if !nb.conn.IsConnected() {
+		health.Error = fmt.Sprintf("nats connection is %v", nb.conn.Status())
+	}
+
+	return health
+}
+
+// durableName replaces the characters a durable consumer name cannot hold, such as the dots and colons of an address
+func durableName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+
+		return '_'
+	}, name)
+}
+
+func (nb *NatsBroker) subscribe(subject, durable string) (*nats.Subscription, error) {
+	js, err := nb.conn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	return js.PullSubscribe(subject, durable,
+		nats.ManualAck(),
+		nats.AckExplicit(),
+		nats.MaxDeliver(nb.opts.maxDeliver),
+	)
+}
+
+// refreshGauges reads every durable consumer from the server, the messages not delivered yet are the depth of the
+// consumer and the targets it feeds also lag by the messages not acked yet
+func (nb *NatsBroker) refreshGauges(ctx context.Context) {
+	nb.mu.Lock()
+	consumers := slices.Clone(nb.consumers)
+	nb.mu.Unlock()
+
+	for _, consumer := range consumers {
+		info, err := consumer.subscriber.ConsumerInfo()
+		if err != nil {
+			logrus.Errorf("read consumer %v is failed with err %v", consumer.queue, err)
+			continue
+		}
+
+		nb.opts.metrics.setQueueDepth(consumer.queue, int64(info.NumPending))
+		for _, target := range consumer.targets {
+			nb.opts.metrics.setLag(nb.syncer.targetId(target), int64(info.NumPending)+int64(info.NumAckPending))
+		}
+	}
+
+	count, err := nb.syncer.deadLetters(ctx)
+	if err == nil {
+		nb.opts.metrics.setDeadLetters(count)
+	}
+}
+
+// consume acks the messages of the durable consumer once the given targets applied them
+func (nb *NatsBroker) consume(subject, durable string, subscriber *nats.Subscription, targets []int) {
+	nb.mu.Lock()
+	nb.consumers = append(nb.consumers, natsConsumer{
+		queue:      fmt.Sprintf("%s/%s", subject, durable),
+		subscriber: subscriber,
+		targets:    targets,
+	})
+	nb.mu.Unlock()
+
+	nb.lifecycle.spawn(func() {
+		defer func() {
+			err := subscriber.Unsubscribe()
+			if err != nil {
+				logrus.Errorf("unsubscribe subject %v is failed with err %v", subject, err)
+			}
+		}()
+
+		// messages of one collection are applied in order while other collections are applied in parallel
+		executor := newPartitionedExecutor(nb.lifecycle.work(), nb.opts.partitions, nb.opts.batchSize, nb.opts.batchLatency, natsPayload, func(messages []*nats.Msg) {
+			nb.handle(nb.lifecycle.work(), messages, targets)
+			nb.lifecycle.end(len(messages))
+		})
+		// the lanes drain the messages they already accepted once consuming stops
+		defer executor.close()
+
+		for !nb.lifecycle.stopping() {
+			messages, err := subscriber.Fetch(int(nb.opts.readCount), nats.MaxWait(nb.opts.readBlock))
+			if err != nil {
+				if errors.Is(err, nats.ErrTimeout) || nb.lifecycle.stopping() {
+					continue
+				}
+
+				logrus.Errorf("fetch message of subject %v is failed with err %v", subject, err)
+				sleep(nb.lifecycle.consuming(), nb.opts.retryDelay)
+				continue
+			}
+
+			// every target may have a consumer of its own, so the messages are recorded by the consumer of the first
+			// one, redeliveries are skipped by their event id and sequence
+			if slices.Contains(targets, 0) {
+				for _, message := range messages {
+					nb.syncer.record(string(message.Data))
+				}
+			}
+
+			// a redelivered message was counted when it was fetched the first time
+			for _, message := range messages {
+				if metadata, err := message.Metadata(); err != nil || metadata.NumDelivered <= 1 {
+					nb.syncer.received([]string{string(message.Data)}, targets)
+				}
+			}
+
+			nb.lifecycle.begin(len(messages))
+			for i, message := range messages {
+				if !executor.submit(nb.lifecycle.work(), message) {
+					nb.lifecycle.end(len(messages) - i)
+					return
+				}
+			}
+		}
+	})
+}
+
+func (nb *NatsBroker) handle(ctx context.Context, messages []*nats.Msg, targets []int) {
+	var (
+		payloads   = make([]string, 0, len(messages))
+		deliveries = make([]int, 0, len(messages))
+	)
+
+	for _, message := range messages {
+		payloads = append(payloads, string(message.Data))
+
+		delivered := 1
+		if metadata, err := message.Metadata(); err == nil {
+			delivered = int(metadata.NumDelivered)
+		}
+
+		deliveries = append(deliveries, delivered)
+	}
+
+	// a message is only buried on its last delivery, the server drops it silently after max deliver
+	failed, invalid := nb.syncer.handleDelivered(ctx, payloads, deliveries, targets)
+	for i, message := range messages {
+		if invalid[i] != nil {
+			logrus.Errorf("message %v is invalid with err %v, terminate it", payloads[i], invalid[i])
+			nb.reply(message.Term())
+			continue
+		}
+
+		// the server redelivers the message after the delay until max deliver is reached
+		if len(failed[i]) > 0 {
+			nb.reply(message.NakWithDelay(nb.opts.retryDelay))
+			continue
+		}
+
+		nb.reply(message.Ack())
+	}
+}
+
+func natsPayload(message *nats.Msg) string {
+	return string(message.Data)
+}
+
+func (nb *NatsBroker) reply(err error) {
+	if err != nil {
+		logrus.Errorf("reply jetstream message is failed with err %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package milvus_cdc
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+)
+
+// newTestNats starts an embedded JetStream server with a stream bound to the subject cdc
+func newTestNats(t *testing.T) (*nats.Conn, nats.JetStreamContext) {
+	t.Helper()
+
+	srv, err := server.NewServer(&server.Options{
+		Host:      "127.0.0.1",
+		Port:      server.RANDOM_PORT,
+		JetStream: true,
+		StoreDir:  t.TempDir(),
+		NoLog:     true,
+		NoSigs:    true,
+	})
+	if err != nil {
+		t.Fatalf("create nats server is failed with err %v", err)
+	}
+
+	go srv.Start()
+	t.Cleanup(srv.Shutdown)
+
+	if !srv.ReadyForConnections(5 * time.Second) {
+		t.Fatalf("nats server is not ready")
+	}
+
+	conn, err := nats.Connect(srv.ClientURL())
+	if err != nil {
+		t.Fatalf("connect nats is failed with err %v", err)
+	}
+	t.Cleanup(conn.Close)
+
+	js, err := conn.JetStream()
+	if err != nil {
+		t.Fatalf("jetstream is failed with err %v", err)
+	}
+
+	_, err = js.AddStream(&nats.StreamConfig{Name: "CDC", Subjects: []string{"cdc"}})
+	if err != nil {
+		t.Fatalf("add stream is failed with err %v", err)
+	}
+
+	return conn, js
+}
+
+func natsPublish(t *testing.T, js nats.JetStreamContext, payload string) {
+	t.Helper()
+
+	_, err := js.Publish("cdc", []byte(payload))
+	if err != nil {
+		t.Fatalf("publish is failed with err %v", err)
+	}
+}
+
+func TestNatsBrokerQueueRedeliversUntilEveryTargetApplied(t *testing.T) {
+	conn, js := newTestNats(t)
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+	second.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	broker := NewNatsBroker(conn, []IMilvusClientInterface{first, second},
+		WithReadBlock(50*time.Millisecond), WithRetryDelay(20*time.Millisecond))
+	startBroker(t, broker, "cdc", Queue)
+
+	natsPublish(t, js, insertPayload(t, "c", 1, 0))
+
+	eventually(t, func() bool {
+		return len(first.Calls()) > 1
+	}, "the message is not redelivered")
+
+	second.setFail(nil)
+
+	eventually(t, func() bool {
+		info, err := js.ConsumerInfo("CDC", DefaultConsumerGroup)
+		return err == nil && slices.Equal(second.Ids(), []int64{1}) && info.NumAckPending == 0 && info.NumPending == 0
+	}, "the message is not acked after every target applied it")
+}
+
+func TestNatsBrokerPubSubUsesStableDurablePerTarget(t *testing.T) {
+	conn, js := newTestNats(t)
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+
+	broker := NewNatsBroker(conn, []IMilvusClientInterface{first, second},
+		WithReadBlock(50*time.Millisecond), WithTargetName(0, "10.0.0.1:19530"), WithTargetName(1, "replica"))
+	startBroker(t, broker, "cdc", PubSub)
+
+	natsPublish(t, js, insertPayload(t, "c", 1, 0))
+
+	eventually(t, func() bool {
+		return slices.Equal(first.Ids(), []int64{1}) && slices.Equal(second.Ids(), []int64{1})
+	}, "the message is not applied to every target")
+
+	for _, durable := range []string{"milvus-cdc-10_0_0_1_19530", "milvus-cdc-replica"} {
+		_, err := js.ConsumerInfo("CDC", durable)
+		if err != nil {
+			t.Fatalf("durable consumer %v is failed with err %v", durable, err)
+		}
+	}
+}
+
+func TestDurableName(t *testing.T) {
+	got := durableName("milvus-cdc-host.local:19530/a b")
+	if got != "milvus-cdc-host_local_19530_a_b" {
+		t.Fatalf("durable name is %v", got)
+	}
+}
+
+func TestNatsBrokerBatchesInsertsOfACollection(t *testing.T) {
+	conn, js := newTestNats(t)
+	target := &fakeMilvus{}
+
+	for id := int64(1); id <= 3; id++ {
+		natsPublish(t, js, insertPayload(t, "c", id, 0))
+	}
+
+	broker := NewNatsBroker(conn, []IMilvusClientInterface{target},
+		WithReadBlock(50*time.Millisecond), WithBatching(3, time.Second))
+	startBroker(t, broker, "cdc", Queue)
+
+	eventually(t, func() bool {
+		info, err := js.ConsumerInfo("CDC", DefaultConsumerGroup)
+		return err == nil && info.NumAckPending == 0 && info.NumPending == 0 && len(target.Ids()) == 3
+	}, "the batch is not acked")
+
+	calls := target.Calls()
+	if len(calls) != 1 || !slices.Equal(calls[0].Ids, []int64{1, 2, 3}) {
+		t.Fatalf("the inserts are applied with the calls %v, wanted one batch", calls)
+	}
+}
+
+func TestNatsBrokerBuriesOnTheLastDelivery(t *testing.T) {
+	conn, js := newTestNats(t)
+	_, client := newTestRedis(t)
+	var attempts atomic.Int32
+	target := &fakeMilvus{}
+	target.setFail(func(fakeCall) error {
+		attempts.Add(1)
+		return errUnavailable
+	})
+
+	sink := NewRedisDeadLetterSink(client, "dlq")
+	broker := NewNatsBroker(conn, []IMilvusClientInterface{target}, WithReadBlock(50*time.Millisecond),
+		WithRetryDelay(20*time.Millisecond), WithDeadLetterSink(sink), WithMaxDeliver(3))
+	startBroker(t, broker, "cdc", Queue)
+
+	natsPublish(t, js, insertPayload(t, "c", 1, 0))
+
+	eventually(t, func() bool {
+		info, err := js.ConsumerInfo("CDC", DefaultConsumerGroup)
+		return err == nil && info.NumAckPending == 0 && info.NumPending == 0 && attempts.Load() == 3
+	}, "the message is not acked after its last delivery")
+
+	letters, err := sink.List(context.Background(), "-", 10)
+	if err != nil {
+		t.Fatalf("list dead letters is failed with err %v", err)
+	}
+
+	if len(letters) != 1 || letters[0].Attempts != 3 {
+		t.Fatalf("the dead letters are %v, wanted one with 3 attempts", letters)
+	}
+}
+
+func TestNatsBrokerReportsTheLagOfEveryConsumer(t *testing.T) {
+	conn, js := newTestNats(t)
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+	second.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	metrics := NewMetrics()
+	broker := NewNatsBroker(conn, []IMilvusClientInterface{first, second},
+		WithReadBlock(50*time.Millisecond), WithRetryDelay(time.Minute),
+		WithMetrics(metrics), WithMetricsInterval(10*time.Millisecond))
+	startBroker(t, broker, "cdc", PubSub)
+
+	natsPublish(t, js, insertPayload(t, "c", 1, 0))
+
+	// the message the second target failed waits for its redelivery
+	eventually(t, func() bool {
+		return len(first.Calls()) == 1 && gauge(t, metrics, "target_lag", "0") == 0 && gauge(t, metrics, "target_lag", "1") == 1
+	}, "the lag of the consumers is not reported, it is %v and %v", gauge(t, metrics, "target_lag", "0"), gauge(t, metrics, "target_lag", "1"))
+}
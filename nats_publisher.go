@@ -0,0 +1,50 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NatsTransport publishes to a JetStream subject, the event id is the message id so the stream drops duplicates
+// published within its duplicate window. The broker id is the stream name and sequence
+type NatsTransport struct {
+	js      nats.JetStreamContext
+	subject string
+}
+
+func NewNatsTransport(conn *nats.Conn, subject string) (*NatsTransport, error) {
+	js, err := conn.JetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	return &NatsTransport{
+		js:      js,
+		subject: subject,
+	}, nil
+}
+
+func NewNatsPublisher(conn *nats.Conn, subject string, opts ...PublisherOption) (*Publisher, error) {
+	transport, err := NewNatsTransport(conn, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPublisher(transport, opts...), nil
+}
+
+func (nt *NatsTransport) Send(ctx context.Context, message *MessageCDC, payload []byte) (string, error) {
+	ack, err := nt.js.Publish(nt.subject, payload, nats.Context(ctx), nats.MsgId(message.EventId))
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%d", ack.Stream, ack.Sequence), nil
+}
+
+// Close leaves the NATS connection open, it is owned by the caller
+func (nt *NatsTransport) Close() error {
+	return nil
+}
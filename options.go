@@ -0,0 +1,284 @@
+package milvus_cdc
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type Option func(*options)
+
+type options struct {
+	consumerGroup  string
+	consumerName   string
+	claimMinIdle   time.Duration
+	readCount      int64
+	readBlock      time.Duration
+	retryDelay     time.Duration
+	maxDeliver     int
+	prefetch       int
+	batchSize      int
+	batchLatency   time.Duration
+	deadLetter     IDeadLetterSink
+	retryPolicy    RetryPolicy
+	maxLag         int64
+	maxQueueLength int64
+	checkpoint     ICheckpointStore
+	partitions     int
+	metrics        *Metrics
+	metricsPeriod  time.Duration
+	tracerProvider trace.TracerProvider
+	snapshotBatch  int
+	eventLog       IEventLog
+	routes         map[int]Route
+	mappings       map[int]NameMapping
+	targetNames    map[int]string
+}
+
+func newOptions(opts ...Option) *options {
+	hostname, _ := os.Hostname()
+
+	o := &options{
+		consumerGroup:  DefaultConsumerGroup,
+		consumerName:   fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+		claimMinIdle:   DefaultClaimMinIdle,
+		readCount:      DefaultReadCount,
+		readBlock:      DefaultReadBlock,
+		retryDelay:     DefaultRetryDelay,
+		maxDeliver:     DefaultMaxDeliver,
+		prefetch:       DefaultPrefetch,
+		batchSize:      DefaultBatchSize,
+		batchLatency:   DefaultBatchLatency,
+		retryPolicy:    DefaultRetryPolicy(),
+		maxLag:         DefaultMaxLag,
+		maxQueueLength: DefaultMaxQueueLength,
+		partitions:     DefaultPartitions,
+		snapshotBatch:  DefaultSnapshotBatchSize,
+		metricsPeriod:  DefaultMetricsInterval,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+func WithConsumerGroup(group, consumer string) Option {
+	return func(o *options) {
+		if group != "" {
+			o.consumerGroup = group
+		}
+
+		if consumer != "" {
+			o.consumerName = consumer
+		}
+	}
+}
+
+// WithClaimMinIdle sets how long a stream entry must stay pending before it is reclaimed by another consumer
+func WithClaimMinIdle(minIdle time.Duration) Option {
+	return func(o *options) {
+		if minIdle > 0 {
+			o.claimMinIdle = minIdle
+		}
+	}
+}
+
+func WithReadCount(count int64) Option {
+	return func(o *options) {
+		if count > 0 {
+			o.readCount = count
+		}
+	}
+}
+
+func WithReadBlock(block time.Duration) Option {
+	return func(o *options) {
+		if block > 0 {
+			o.readBlock = block
+		}
+	}
+}
+
+func WithRetryDelay(delay time.Duration) Option {
+	return func(o *options) {
+		if delay > 0 {
+			o.retryDelay = delay
+		}
+	}
+}
+
+func WithMaxDeliver(maxDeliver int) Option {
+	return func(o *options) {
+		if maxDeliver != 0 {
+			o.maxDeliver = maxDeliver
+		}
+	}
+}
+
+func WithPrefetch(prefetch int) Option {
+	return func(o *options) {
+		if prefetch > 0 {
+			o.prefetch = prefetch
+		}
+	}
+}
+
+// WithBatching merges consecutive inserts or deletes of the same collection and partition into one request of at
+// most size messages, waiting at most latency for a batch to fill up
+func WithBatching(size int, latency time.Duration) Option {
+	return func(o *options) {
+		if size > 0 {
+			o.batchSize = size
+		}
+
+		if latency > 0 {
+			o.batchLatency = latency
+		}
+	}
+}
+
+// WithDeadLetterSink stores messages that fail to sync in the sink instead of retrying or dropping them
+func WithDeadLetterSink(sink IDeadLetterSink) Option {
+	return func(o *options) {
+		o.deadLetter = sink
+	}
+}
+
+// WithRetryPolicy retries a failed apply on a target before it is reported as failed or dead lettered
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *options) {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = DefaultMaxAttempts
+		}
+
+		if policy.Multiplier < 1 {
+			policy.Multiplier = DefaultMultiplier
+		}
+
+		if policy.RetryOn == nil {
+			policy.RetryOn = DefaultRetryPolicy().RetryOn
+		}
+
+		o.retryPolicy = policy
+	}
+}
+
+// WithMaxLag sets how many messages a target may lag behind in the queue pattern before it is marked unhealthy
+func WithMaxLag(maxLag int64) Option {
+	return func(o *options) {
+		if maxLag > 0 {
+			o.maxLag = maxLag
+		}
+	}
+}
+
+// WithMaxQueueLength caps the queue of every target in the queue pattern, a target whose queue is full does not get
+// new messages, they are stored in the dead letter sink for it instead
+func WithMaxQueueLength(length int64) Option {
+	return func(o *options) {
+		if length > 0 {
+			o.maxQueueLength = length
+		}
+	}
+}
+
+// WithCheckpointStore skips events whose sequence is not above the highest sequence already applied to the target,
+// the redis broker uses a RedisCheckpointStore by default
+func WithCheckpointStore(store ICheckpointStore) Option {
+	return func(o *options) {
+		o.checkpoint = store
+	}
+}
+
+// WithPartitions sets how many collections are applied in parallel per target in the pub-sub pattern, messages of
+// the same collection always share a partition and are applied in order
+func WithPartitions(partitions int) Option {
+	return func(o *options) {
+		if partitions > 0 {
+			o.partitions = partitions
+		}
+	}
+}
+
+// WithMetrics records the received, applied and failed messages, retries, queue depths, lags and dead letters
+func WithMetrics(metrics *Metrics) Option {
+	return func(o *options) {
+		o.metrics = metrics
+	}
+}
+
+// WithMetricsInterval sets how often the queue depths, lags and dead letters are read from the broker
+func WithMetricsInterval(interval time.Duration) Option {
+	return func(o *options) {
+		if interval > 0 {
+			o.metricsPeriod = interval
+		}
+	}
+}
+
+// WithTracerProvider traces the sync of every message and its Milvus calls with the provider instead of the global one
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = provider
+	}
+}
+
+// WithSnapshotBatchSize sets how many entities a bootstrap reads from the source and inserts into the target at once
+func WithSnapshotBatchSize(size int) Option {
+	return func(o *options) {
+		if size > 0 {
+			o.snapshotBatch = size
+		}
+	}
+}
+
+// WithEventLog records every valid message in the log as the broker consumes it, before it is fanned out or applied,
+// a redelivered message with the same event id and sequence is recorded once
+func WithEventLog(log IEventLog) Option {
+	return func(o *options) {
+		o.eventLog = log
+	}
+}
+
+// WithRoute applies to the target only the messages the route matches, the others are skipped and counted as filtered
+func WithRoute(target int, route Route) Option {
+	return func(o *options) {
+		if o.routes == nil {
+			o.routes = make(map[int]Route)
+		}
+
+		o.routes[target] = route
+	}
+}
+
+// WithNameMapping renames the collections and partitions of the messages applied to the target, the routes and the
+// checkpoints keep using the names of the messages
+func WithNameMapping(target int, mapping NameMapping) Option {
+	return func(o *options) {
+		if o.mappings == nil {
+			o.mappings = make(map[int]NameMapping)
+		}
+
+		o.mappings[target] = mapping
+	}
+}
+
+// WithTargetName names the target in the consumer groups and checkpoints, by default a target is named after the
+// address of its client so the name survives a reordering of the targets
+func WithTargetName(target int, name string) Option {
+	return func(o *options) {
+		if name == "" {
+			return
+		}
+
+		if o.targetNames == nil {
+			o.targetNames = make(map[int]string)
+		}
+
+		o.targetNames[target] = name
+	}
+}
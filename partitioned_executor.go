@@ -0,0 +1,76 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"sync"
+	"time"
+)
+
+// partitionedExecutor runs one lane per partition, messages of the same collection always land on the same lane and
+// are handled in order while messages of other collections are handled in parallel on the other lanes. payload reads
+// the MessageCDC json of a message, such as the body of a delivery
+type partitionedExecutor[T any] struct {
+	lanes   []chan T
+	payload func(T) string
+	wg      sync.WaitGroup
+}
+
+func newPartitionedExecutor[T any](ctx context.Context, partitions, size int, latency time.Duration, payload func(T) string, handle func(batch []T)) *partitionedExecutor[T] {
+	e := &partitionedExecutor[T]{
+		lanes:   make([]chan T, partitions),
+		payload: payload,
+	}
+
+	for i := range e.lanes {
+		e.lanes[i] = make(chan T, size)
+		e.wg.Add(1)
+		go func(lane <-chan T) {
+			defer e.wg.Done()
+			for {
+				batch := collect(ctx, lane, size, latency)
+				if batch == nil {
+					return
+				}
+
+				handle(batch)
+			}
+		}(e.lanes[i])
+	}
+
+	return e
+}
+
+// submit blocks until the lane of the message accepts it or the context is done, it reports whether it was accepted
+func (e *partitionedExecutor[T]) submit(ctx context.Context, message T) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case e.lanes[e.partition(e.payload(message))] <- message:
+		return true
+	}
+}
+
+// close stops accepting payloads and waits for the lanes to handle what they already accepted
+func (e *partitionedExecutor[T]) close() {
+	for _, lane := range e.lanes {
+		close(lane)
+	}
+
+	e.wg.Wait()
+}
+
+func (e *partitionedExecutor[T]) partition(payload string) int {
+	var key struct {
+		CollectionName string `json:"collection_name"`
+	}
+
+	// an invalid payload still goes to a lane, where it is rejected and logged like any other invalid message
+	_ = json.Unmarshal([]byte(payload), &key)
+
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(key.CollectionName))
+
+	return int(hash.Sum32() % uint32(len(e.lanes)))
+}
@@ -0,0 +1,116 @@
+package milvus_cdc
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPartitionedExecutorKeepsTheOrderOfACollection(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		handled []string
+	)
+
+	executor := newPartitionedExecutor(context.Background(), 4, 2, time.Millisecond, identity, func(batch []string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		handled = append(handled, batch...)
+	})
+
+	payloads := make([]string, 0, 10)
+	for id := int64(1); id <= 10; id++ {
+		payloads = append(payloads, insertPayload(t, "c", id, id))
+	}
+
+	for _, payload := range payloads {
+		if !executor.submit(context.Background(), payload) {
+			t.Fatalf("the payload %v is not accepted", payload)
+		}
+	}
+
+	// close waits for the payloads the lanes already accepted
+	executor.close()
+
+	if !slices.Equal(handled, payloads) {
+		t.Fatalf("the payloads are handled in the order %v", handled)
+	}
+}
+
+func TestPartitionedExecutorAppliesOtherCollectionsInParallel(t *testing.T) {
+	var (
+		blocked = make(chan struct{})
+		applied = make(chan string, 1)
+	)
+
+	executor := newPartitionedExecutor(context.Background(), 2, 1, time.Millisecond, identity, func(batch []string) {
+		message, _ := (&syncer{}).decode(batch[0])
+		if message.CollectionName == "slow" {
+			<-blocked
+			return
+		}
+
+		applied <- message.CollectionName
+	})
+	defer executor.close()
+	defer close(blocked)
+
+	// find a collection that does not share the lane of the slow one
+	slow := insertPayload(t, "slow", 1, 0)
+	other := ""
+	for i := 0; other == ""; i++ {
+		payload := insertPayload(t, string(rune('a'+i)), 1, 0)
+		if executor.partition(payload) != executor.partition(slow) {
+			other = payload
+		}
+	}
+
+	executor.submit(context.Background(), slow)
+	executor.submit(context.Background(), other)
+
+	select {
+	case <-applied:
+	case <-time.After(time.Second):
+		t.Fatalf("a collection is blocked by a slow collection on another lane")
+	}
+}
+
+func TestRedisBrokerPubSubAppliesACollectionInOrder(t *testing.T) {
+	_, client := newTestRedis(t)
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+
+	broker := NewRedisBroker(client, []IMilvusClientInterface{first, second}, WithPartitions(4))
+	startBroker(t, broker, "cdc", PubSub)
+
+	eventually(t, func() bool {
+		return client.PubSubNumSub(context.Background(), "cdc").Val()["cdc"] == 2
+	}, "the targets do not subscribe")
+
+	messages := []string{
+		payload(t, &MessageCDC{Action: DropCollection, CollectionName: "c"}),
+		payload(t, &MessageCDC{Action: CreateCollection, CollectionName: "c", Dimension: 1}),
+		insertPayload(t, "c", 1, 0),
+	}
+
+	for _, message := range messages {
+		err := client.Publish(context.Background(), "cdc", message).Err()
+		if err != nil {
+			t.Fatalf("publish is failed with err %v", err)
+		}
+	}
+
+	want := []string{DropCollection, CreateCollection, Insert}
+	for _, target := range []*fakeMilvus{first, second} {
+		eventually(t, func() bool {
+			var ops []string
+			for _, call := range target.Calls() {
+				ops = append(ops, call.Op)
+			}
+
+			return slices.Equal(ops, want)
+		}, "the actions of the collection are not applied in order")
+	}
+}
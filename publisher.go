@@ -0,0 +1,243 @@
+package milvus_cdc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+)
+
+// Publisher builds, validates and encodes the messages of producers and sends them through the transport matching
+// the broker and pattern the workers consume
+type Publisher struct {
+	transport IPublisherTransport
+	sequencer ISequencer
+	// collections serialise the sequencing and sending of the messages of a collection
+	collections collectionLocks
+}
+
+type PublisherOption func(*Publisher)
+
+// WithSequencer gives every message without a sequence the next one of its collection
+func WithSequencer(sequencer ISequencer) PublisherOption {
+	return func(p *Publisher) {
+		p.sequencer = sequencer
+	}
+}
+
+func NewPublisher(transport IPublisherTransport, opts ...PublisherOption) *Publisher {
+	p := &Publisher{
+		transport: transport,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Publish validates the message for its action, gives it an event id and a sequence when it has none, stores the span
+// of ctx in it and sends it. It returns the id the broker assigned to the message. The messages of a collection are
+// sequenced and sent one at a time, so they reach the broker in the order of their sequences
+func (p *Publisher) Publish(ctx context.Context, message *MessageCDC) (string, error) {
+	err := message.validateFields()
+	if err != nil {
+		return "", fmt.Errorf("the %v message is invalid: %w", message.Action, err)
+	}
+
+	if message.EventId == "" {
+		message.EventId, err = newEventId()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if p.sequencer != nil && message.Sequence == 0 {
+		unlock := p.collections.lock(message.CollectionName)
+		defer unlock()
+
+		message.Sequence, err = p.sequencer.Next(ctx, message.CollectionName)
+		if err != nil {
+			return "", fmt.Errorf("sequence %v message of collection %v is failed with err %w", message.Action,
+				message.CollectionName, err)
+		}
+	}
+
+	message.InjectTrace(ctx)
+
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	id, err := p.transport.Send(ctx, message, payload)
+	if err != nil {
+		return "", fmt.Errorf("publish %v message of collection %v is failed with err %w", message.Action,
+			message.CollectionName, err)
+	}
+
+	return id, nil
+}
+
+// collectionLocks hold a mutex per collection, the zero value is ready to use
+type collectionLocks struct {
+	mu          sync.Mutex
+	collections map[string]*sync.Mutex
+}
+
+// lock serialises the work on the collection and returns the unlock
+func (l *collectionLocks) lock(collectionName string) func() {
+	l.mu.Lock()
+	if l.collections == nil {
+		l.collections = make(map[string]*sync.Mutex)
+	}
+
+	collection, ok := l.collections[collectionName]
+	if !ok {
+		collection = &sync.Mutex{}
+		l.collections[collectionName] = collection
+	}
+	l.mu.Unlock()
+
+	collection.Lock()
+
+	return collection.Unlock
+}
+
+func (p *Publisher) PublishInsert(ctx context.Context, collectionName, partitionTag string, id int64, vector []float32) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         Insert,
+		CollectionName: collectionName,
+		PartitionTag:   partitionTag,
+		Id:             id,
+		Vector:         EncodeVector(vector),
+	})
+}
+
+// PublishInsertBatch inserts several entities with one message, ids and vectors are matched by index
+func (p *Publisher) PublishInsertBatch(ctx context.Context, collectionName, partitionTag string, ids []int64, vectors [][]float32) (string, error) {
+	encoded := make([]string, 0, len(vectors))
+	for _, vector := range vectors {
+		encoded = append(encoded, EncodeVector(vector))
+	}
+
+	return p.Publish(ctx, &MessageCDC{
+		Action:         Insert,
+		CollectionName: collectionName,
+		PartitionTag:   partitionTag,
+		Ids:            ids,
+		Vectors:        encoded,
+	})
+}
+
+// PublishInsertWithFields inserts one entity with the scalar fields of a collection created with a schema
+func (p *Publisher) PublishInsertWithFields(ctx context.Context, collectionName, partitionTag string, id int64, vector []float32, fields map[string]interface{}) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         Insert,
+		CollectionName: collectionName,
+		PartitionTag:   partitionTag,
+		Id:             id,
+		Vector:         EncodeVector(vector),
+		Fields:         fields,
+	})
+}
+
+func (p *Publisher) PublishDelete(ctx context.Context, collectionName, partitionTag string, ids ...int64) (string, error) {
+	if len(ids) == 0 {
+		return "", fmt.Errorf("the delete message is invalid: the ids are empty")
+	}
+
+	return p.Publish(ctx, &MessageCDC{
+		Action:         Delete,
+		CollectionName: collectionName,
+		PartitionTag:   partitionTag,
+		Ids:            ids,
+	})
+}
+
+// PublishCreateCollection creates a collection, the schema describes its scalar fields and is only applied by the
+// Milvus 2.x targets
+func (p *Publisher) PublishCreateCollection(ctx context.Context, collectionName string, dimension, indexFileSize int64, metricType milvus.MetricType, schema ...FieldSchema) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         CreateCollection,
+		CollectionName: collectionName,
+		Dimension:      dimension,
+		IndexFileSize:  indexFileSize,
+		MetricType:     metricType,
+		Schema:         schema,
+	})
+}
+
+func (p *Publisher) PublishDropCollection(ctx context.Context, collectionName string) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         DropCollection,
+		CollectionName: collectionName,
+	})
+}
+
+func (p *Publisher) PublishCreatePartition(ctx context.Context, collectionName, partitionTag string) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         CreatePartition,
+		CollectionName: collectionName,
+		PartitionTag:   partitionTag,
+	})
+}
+
+func (p *Publisher) PublishDropPartition(ctx context.Context, collectionName, partitionTag string) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         DropPartition,
+		CollectionName: collectionName,
+		PartitionTag:   partitionTag,
+	})
+}
+
+func (p *Publisher) PublishCreateIndex(ctx context.Context, collectionName string, indexType milvus.IndexType, nList int64) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         CreateIndex,
+		CollectionName: collectionName,
+		IndexType:      indexType,
+		NList:          nList,
+	})
+}
+
+func (p *Publisher) PublishDropIndex(ctx context.Context, collectionName string) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         DropIndex,
+		CollectionName: collectionName,
+	})
+}
+
+func (p *Publisher) PublishLoadCollection(ctx context.Context, collectionName string) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         LoadCollection,
+		CollectionName: collectionName,
+	})
+}
+
+func (p *Publisher) PublishReleaseCollection(ctx context.Context, collectionName string) (string, error) {
+	return p.Publish(ctx, &MessageCDC{
+		Action:         ReleaseCollection,
+		CollectionName: collectionName,
+	})
+}
+
+// Close releases what the transport created, the connections given to the transport stay open
+func (p *Publisher) Close() error {
+	return p.transport.Close()
+}
+
+func newEventId() (string, error) {
+	b := make([]byte, 16)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("generate event id is failed with err %v", err)
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,121 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// queued decodes the messages of the redis list in the order they were pushed
+func queued(t *testing.T, redisCli *RedisClient, queue string) []*MessageCDC {
+	t.Helper()
+
+	var messages []*MessageCDC
+	for {
+		payload, err := redisCli.redis.RPop(context.Background(), queue).Result()
+		if err != nil {
+			return messages
+		}
+
+		var message MessageCDC
+		err = json.Unmarshal([]byte(payload), &message)
+		if err != nil {
+			t.Fatalf("decode message is failed with err %v", err)
+		}
+
+		messages = append(messages, &message)
+	}
+}
+
+func TestRedisPublisherSequencesEveryCollection(t *testing.T) {
+	_, client := newTestRedis(t)
+
+	publisher, err := NewRedisPublisher(client, "cdc", Queue)
+	if err != nil {
+		t.Fatalf("new publisher is failed with err %v", err)
+	}
+
+	ctx := context.Background()
+	for _, message := range []*MessageCDC{
+		{Action: DropCollection, CollectionName: "c"},
+		{Action: DropCollection, CollectionName: "d"},
+		{Action: DropCollection, CollectionName: "c"},
+		{Action: DropCollection, CollectionName: "c", Sequence: 10},
+	} {
+		_, err = publisher.Publish(ctx, message)
+		if err != nil {
+			t.Fatalf("publish is failed with err %v", err)
+		}
+	}
+
+	var sequences []int64
+	for _, message := range queued(t, NewRedisClient(client), "cdc") {
+		sequences = append(sequences, message.Sequence)
+	}
+
+	// a message that has a sequence keeps it
+	if len(sequences) != 4 || sequences[0] != 1 || sequences[1] != 1 || sequences[2] != 2 || sequences[3] != 10 {
+		t.Fatalf("the sequences are %v, wanted [1 1 2 10]", sequences)
+	}
+}
+
+func TestPublisherSendsTheMessagesOfACollectionInSequenceOrder(t *testing.T) {
+	_, client := newTestRedis(t)
+
+	publisher, err := NewRedisPublisher(client, "cdc", Queue)
+	if err != nil {
+		t.Fatalf("new publisher is failed with err %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, errPublish := publisher.PublishDropCollection(context.Background(), "c")
+			if errPublish != nil {
+				t.Errorf("publish is failed with err %v", errPublish)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	messages := queued(t, NewRedisClient(client), "cdc")
+	if len(messages) != 20 {
+		t.Fatalf("%d messages are sent, wanted 20", len(messages))
+	}
+
+	for i, message := range messages {
+		if message.Sequence != int64(i+1) {
+			t.Fatalf("the message %d has the sequence %d, the messages are not sent in sequence order", i, message.Sequence)
+		}
+	}
+}
+
+type failingSequencer struct{}
+
+func (failingSequencer) Next(context.Context, string) (int64, error) {
+	return 0, errors.New("sequencer is down")
+}
+
+func TestPublisherDoesNotSendWithoutASequence(t *testing.T) {
+	_, client := newTestRedis(t)
+
+	publisher, err := NewRedisPublisher(client, "cdc", Queue, WithSequencer(failingSequencer{}))
+	if err != nil {
+		t.Fatalf("new publisher is failed with err %v", err)
+	}
+
+	_, err = publisher.PublishDropCollection(context.Background(), "c")
+	if err == nil {
+		t.Fatalf("a message without a sequence is published")
+	}
+
+	if messages := queued(t, NewRedisClient(client), "cdc"); len(messages) > 0 {
+		t.Fatalf("the messages %v are sent", messages)
+	}
+}
@@ -0,0 +1,293 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/sirupsen/logrus"
+)
+
+// amqpInspector is the part of amqp.Channel that reads the queues for the gauges
+type amqpInspector interface {
+	QueueDeclarePassive(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	Close() error
+}
+
+type RabbitMQBroker struct {
+	conn         *amqp.Connection
+	syncer       *syncer
+	opts         *options
+	lifecycle    *lifecycle
+	newInspector func() (amqpInspector, error)
+	mu           sync.Mutex
+	queue        string
+	deadLetter   string
+}
+
+func NewRabbitMQBroker(conn *amqp.Connection, milvus []IMilvusClientInterface, opts ...Option) *RabbitMQBroker {
+	o := newOptions(opts...)
+
+	mb := &RabbitMQBroker{
+		conn:   conn,
+		syncer: newSyncer(milvus, o),
+		opts:   o,
+	}
+
+	mb.lifecycle = newLifecycle()
+	mb.newInspector = func() (amqpInspector, error) {
+		return mb.conn.Channel()
+	}
+
+	return mb
+}
+
+func (mb *RabbitMQBroker) Start(channel, pattern string) error {
+	mb.lifecycle.start()
+
+	switch pattern {
+	case PubSub:
+		return mb.consume(channel, true)
+	case Queue:
+		return mb.consume(channel, false)
+	}
+
+	return fmt.Errorf("pattern is invalid")
+}
+
+// Stop stops consuming new deliveries and waits until ctx is done for the deliveries in flight, the connections stay
+// open for the caller to close or to Start again. Deliveries left unprocessed are not acknowledged so the server
+// requeues them
+func (mb *RabbitMQBroker) Stop(ctx context.Context) error {
+	return mb.lifecycle.stop(ctx)
+}
+
+func (mb *RabbitMQBroker) Health(ctx context.Context) BrokerHealth {
+	health := BrokerHealth{
+		Broker:  RabbitMQ,
+		Alive:   mb.lifecycle.alive(),
+		Targets: mb.syncer.health(ctx),
+	}
+
+	if mb.conn.IsClosed() {
+		health.Error = "rabbitmq connection is closed"
+	}
+
+	return health
+}
+
+func (mb *RabbitMQBroker) consume(channel string, fanout bool) error {
+	ch, err := mb.conn.Channel()
+	if err != nil {
+		return err
+	}
+
+	deliveries, queue, deadLetter, err := mb.subscribe(ch, channel, fanout)
+	if err != nil {
+		_ = ch.Close()
+		return err
+	}
+
+	mb.mu.Lock()
+	mb.queue, mb.deadLetter = queue, deadLetter
+	mb.mu.Unlock()
+
+	if mb.opts.metrics != nil {
+		mb.lifecycle.spawn(func() {
+			report(mb.lifecycle.consuming(), mb.opts.metricsPeriod, mb.refreshGauges)
+		})
+	}
+
+	mb.lifecycle.spawn(func() {
+		// closing the channel requeues the prefetched deliveries that were not handled
+		defer ch.Close()
+
+		// deliveries of one collection are applied in order while other collections are applied in parallel
+		executor := newPartitionedExecutor(mb.lifecycle.work(), mb.opts.partitions, mb.opts.batchSize, mb.opts.batchLatency, amqpPayload, func(deliveries []amqp.Delivery) {
+			mb.handle(mb.lifecycle.work(), deliveries)
+			mb.lifecycle.end(len(deliveries))
+		})
+		// the lanes drain the deliveries they already accepted before the channel is closed
+		defer executor.close()
+
+		for {
+			select {
+			case <-mb.lifecycle.consuming().Done():
+				err := ch.Cancel(mb.opts.consumerName, false)
+				if err != nil {
+					logrus.Errorf("cancel consumer of %v is failed with err %v", channel, err)
+				}
+
+				return
+			case delivery, ok := <-deliveries:
+				if !ok {
+					return
+				}
+
+				mb.syncer.record(string(delivery.Body))
+
+				// a requeued delivery was counted when it was delivered the first time
+				if !delivery.Redelivered {
+					mb.syncer.received([]string{string(delivery.Body)}, mb.syncer.targets())
+				}
+
+				mb.lifecycle.begin(1)
+				if !executor.submit(mb.lifecycle.work(), delivery) {
+					mb.lifecycle.end(1)
+					return
+				}
+			}
+		}
+	})
+
+	mb.lifecycle.wait()
+
+	return nil
+}
+
+// subscribe declares the queues and consumes the work queue, it returns the names of the work queue and of the dead
+// letter queue which is named after its exchange
+func (mb *RabbitMQBroker) subscribe(ch *amqp.Channel, channel string, fanout bool) (<-chan amqp.Delivery, string, string, error) {
+	err := ch.Qos(mb.opts.prefetch, 0, false)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	deadLetter, err := mb.declareDeadLetter(ch, channel)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	queue, err := mb.declareQueue(ch, channel, deadLetter, fanout)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	deliveries, err := ch.Consume(queue, mb.opts.consumerName, false, false, false, false, nil)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	return deliveries, queue, deadLetter, nil
+}
+
+// refreshGauges declares the work queue and the dead letter queue passively to read their ready messages, every
+// target applies a delivery so they all lag by the ready messages of the work queue
+func (mb *RabbitMQBroker) refreshGauges(ctx context.Context) {
+	mb.mu.Lock()
+	queue, deadLetterQueue := mb.queue, mb.deadLetter
+	mb.mu.Unlock()
+
+	// a failed passive declare closes the channel, so every refresh opens its own
+	inspector, err := mb.newInspector()
+	if err != nil {
+		logrus.Errorf("open channel for the gauges is failed with err %v", err)
+		return
+	}
+	defer inspector.Close()
+
+	work, err := inspector.QueueDeclarePassive(queue, false, false, false, false, nil)
+	if err != nil {
+		logrus.Errorf("read queue %v is failed with err %v", queue, err)
+		return
+	}
+
+	mb.opts.metrics.setQueueDepth(work.Name, int64(work.Messages))
+	for _, target := range mb.syncer.targets() {
+		mb.opts.metrics.setLag(mb.syncer.targetId(target), int64(work.Messages))
+	}
+
+	deadLetter, err := inspector.QueueDeclarePassive(deadLetterQueue, false, false, false, false, nil)
+	if err != nil {
+		logrus.Errorf("read queue %v is failed with err %v", deadLetterQueue, err)
+		return
+	}
+
+	count, err := mb.syncer.deadLetters(ctx)
+	if err == nil {
+		mb.opts.metrics.setDeadLetters(count + int64(deadLetter.Messages))
+	}
+}
+
+func (mb *RabbitMQBroker) declareDeadLetter(ch *amqp.Channel, channel string) (string, error) {
+	exchange := channel + DeadLetterSuffix
+	err := ch.ExchangeDeclare(exchange, amqp.ExchangeFanout, true, false, false, false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	queue, err := ch.QueueDeclare(exchange, true, false, false, false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	err = ch.QueueBind(queue.Name, "", exchange, false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return exchange, nil
+}
+
+// declareQueue binds a server named exclusive queue to a fanout exchange for pub-sub, so every worker receives
+// all messages, or declares a durable work queue shared by all workers
+func (mb *RabbitMQBroker) declareQueue(ch *amqp.Channel, channel, deadLetter string, fanout bool) (string, error) {
+	args := amqp.Table{
+		"x-dead-letter-exchange": deadLetter,
+	}
+
+	if !fanout {
+		queue, err := ch.QueueDeclare(channel, true, false, false, false, args)
+		if err != nil {
+			return "", err
+		}
+
+		return queue.Name, nil
+	}
+
+	err := ch.ExchangeDeclare(channel, amqp.ExchangeFanout, true, false, false, false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	queue, err := ch.QueueDeclare("", false, true, true, false, args)
+	if err != nil {
+		return "", err
+	}
+
+	err = ch.QueueBind(queue.Name, "", channel, false, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return queue.Name, nil
+}
+
+func (mb *RabbitMQBroker) handle(ctx context.Context, deliveries []amqp.Delivery) {
+	payloads := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		payloads = append(payloads, string(delivery.Body))
+	}
+
+	failed, invalid := mb.syncer.handleBatch(ctx, payloads, mb.syncer.targets())
+	for i, delivery := range deliveries {
+		if invalid[i] != nil || len(failed[i]) > 0 {
+			logrus.Errorf("message %v is not applied with err %v and failed targets %v, move it to dead letter", payloads[i], invalid[i], failed[i])
+			mb.reply(delivery.Nack(false, false))
+			continue
+		}
+
+		mb.reply(delivery.Ack(false))
+	}
+}
+
+func amqpPayload(delivery amqp.Delivery) string {
+	return string(delivery.Body)
+}
+
+func (mb *RabbitMQBroker) reply(err error) {
+	if err != nil {
+		logrus.Errorf("reply amqp delivery is failed with err %v", err)
+	}
+}
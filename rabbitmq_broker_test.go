@@ -0,0 +1,150 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// fakeAcknowledger records the replies to the deliveries
+type fakeAcknowledger struct {
+	mu      sync.Mutex
+	acks    []uint64
+	nacks   []uint64
+	requeue []bool
+}
+
+func (f *fakeAcknowledger) Ack(tag uint64, _ bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.acks = append(f.acks, tag)
+
+	return nil
+}
+
+func (f *fakeAcknowledger) Nack(tag uint64, _, requeue bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.nacks = append(f.nacks, tag)
+	f.requeue = append(f.requeue, requeue)
+
+	return nil
+}
+
+func (f *fakeAcknowledger) Reject(tag uint64, requeue bool) error {
+	return f.Nack(tag, false, requeue)
+}
+
+func delivery(acknowledger amqp.Acknowledger, tag uint64, body string) amqp.Delivery {
+	return amqp.Delivery{Acknowledger: acknowledger, DeliveryTag: tag, Body: []byte(body)}
+}
+
+func TestRabbitMQBrokerHandle(t *testing.T) {
+	target := &fakeMilvus{}
+	target.setFail(func(call fakeCall) error {
+		if call.Ids[0] == 2 {
+			return newInvalidError("fake", fmt.Errorf("invalid"))
+		}
+
+		return nil
+	})
+
+	broker := NewRabbitMQBroker(nil, []IMilvusClientInterface{target})
+	acknowledger := &fakeAcknowledger{}
+
+	broker.handle(context.Background(), []amqp.Delivery{
+		delivery(acknowledger, 1, insertPayload(t, "c", 1, 0)),
+		delivery(acknowledger, 2, insertPayload(t, "c", 2, 0)),
+		delivery(acknowledger, 3, "{"),
+	})
+
+	if len(acknowledger.acks) != 1 || acknowledger.acks[0] != 1 {
+		t.Fatalf("the acked deliveries are %v, wanted [1]", acknowledger.acks)
+	}
+
+	if len(acknowledger.nacks) != 2 || acknowledger.nacks[0] != 2 || acknowledger.nacks[1] != 3 {
+		t.Fatalf("the nacked deliveries are %v, wanted [2 3]", acknowledger.nacks)
+	}
+
+	// a nack without requeue routes the delivery to the dead letter exchange
+	for _, requeue := range acknowledger.requeue {
+		if requeue {
+			t.Fatalf("a failed delivery is requeued instead of dead lettered")
+		}
+	}
+}
+
+func TestRabbitMQBrokerHandleBatchesInserts(t *testing.T) {
+	target := &fakeMilvus{}
+	broker := NewRabbitMQBroker(nil, []IMilvusClientInterface{target}, WithBatching(2, time.Second))
+	acknowledger := &fakeAcknowledger{}
+
+	broker.handle(context.Background(), []amqp.Delivery{
+		delivery(acknowledger, 1, insertPayload(t, "c", 1, 0)),
+		delivery(acknowledger, 2, insertPayload(t, "c", 2, 0)),
+	})
+
+	calls := target.Calls()
+	if len(calls) != 1 || len(calls[0].Ids) != 2 || len(acknowledger.acks) != 2 {
+		t.Fatalf("the deliveries are applied with the calls %v and acked %v", calls, acknowledger.acks)
+	}
+}
+
+// fakeInspector answers the passive declares with the ready messages of the queues
+type fakeInspector struct {
+	messages map[string]int
+}
+
+func (f *fakeInspector) QueueDeclarePassive(name string, _, _, _, _ bool, _ amqp.Table) (amqp.Queue, error) {
+	messages, ok := f.messages[name]
+	if !ok {
+		return amqp.Queue{}, fmt.Errorf("queue %v is not found", name)
+	}
+
+	return amqp.Queue{Name: name, Messages: messages}, nil
+}
+
+func (f *fakeInspector) Close() error {
+	return nil
+}
+
+func TestRabbitMQBrokerReportsGauges(t *testing.T) {
+	_, client := newTestRedis(t)
+	sink := NewRedisDeadLetterSink(client, "")
+
+	_, err := sink.Put(context.Background(), &DeadLetter{Payload: "{", Target: 0})
+	if err != nil {
+		t.Fatalf("put dead letter is failed with err %v", err)
+	}
+
+	metrics := NewMetrics()
+	broker := NewRabbitMQBroker(nil, []IMilvusClientInterface{&fakeMilvus{}, &fakeMilvus{}},
+		WithMetrics(metrics), WithDeadLetterSink(sink))
+	broker.queue, broker.deadLetter = "cdc", "cdc"+DeadLetterSuffix
+	broker.newInspector = func() (amqpInspector, error) {
+		return &fakeInspector{messages: map[string]int{"cdc": 3, "cdc" + DeadLetterSuffix: 2}}, nil
+	}
+
+	broker.refreshGauges(context.Background())
+
+	if depth := gauge(t, metrics, "queue_depth", "cdc"); depth != 3 {
+		t.Fatalf("the depth of the queue is %v, wanted 3", depth)
+	}
+
+	for _, target := range []string{"0", "1"} {
+		if lag := gauge(t, metrics, "target_lag", target); lag != 3 {
+			t.Fatalf("the lag of target %v is %v, wanted 3", target, lag)
+		}
+	}
+
+	// the dead letter queue and the sink are both counted
+	if count := gauge(t, metrics, "dead_letters", ""); count != 3 {
+		t.Fatalf("the dead letters are %v, wanted 3", count)
+	}
+}
@@ -0,0 +1,86 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// RabbitMQTransport publishes persistent messages to the fanout exchange of the pub-sub pattern or to the work queue
+// of the queue pattern, and waits for the publisher confirm of each of them. RabbitMQ assigns no id, the event id is
+// the message id of the delivery
+type RabbitMQTransport struct {
+	ch         *amqp.Channel
+	mu         sync.Mutex
+	exchange   string
+	routingKey string
+}
+
+func NewRabbitMQTransport(conn *amqp.Connection, channel, pattern string) (*RabbitMQTransport, error) {
+	mt := &RabbitMQTransport{}
+	switch pattern {
+	case PubSub:
+		mt.exchange = channel
+	case Queue:
+		mt.routingKey = channel
+	default:
+		return nil, fmt.Errorf("pattern is invalid")
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ch.Confirm(false)
+	if err != nil {
+		_ = ch.Close()
+		return nil, err
+	}
+
+	mt.ch = ch
+
+	return mt, nil
+}
+
+func NewRabbitMQPublisher(conn *amqp.Connection, channel, pattern string, opts ...PublisherOption) (*Publisher, error) {
+	transport, err := NewRabbitMQTransport(conn, channel, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPublisher(transport, opts...), nil
+}
+
+func (mt *RabbitMQTransport) Send(ctx context.Context, message *MessageCDC, payload []byte) (string, error) {
+	mt.mu.Lock()
+	confirmation, err := mt.ch.PublishWithDeferredConfirmWithContext(ctx, mt.exchange, mt.routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		MessageId:    message.EventId,
+		Body:         payload,
+	})
+	mt.mu.Unlock()
+
+	if err != nil {
+		return "", err
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if !acked {
+		return "", fmt.Errorf("the message is not confirmed by rabbitmq")
+	}
+
+	return message.EventId, nil
+}
+
+// Close closes the channel of the transport and leaves the connection open, it is owned by the caller
+func (mt *RabbitMQTransport) Close() error {
+	return mt.ch.Close()
+}
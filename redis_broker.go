@@ -2,183 +2,431 @@ package milvus_cdc
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"os"
+	"strings"
 	"sync"
-	"syscall"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/sirupsen/logrus"
 )
 
 type RedisBroker struct {
-	sig      chan os.Signal
-	redisCli *RedisClient
-	milvus   []*MilvusClient
+	redisCli  *RedisClient
+	syncer    *syncer
+	opts      *options
+	lifecycle *lifecycle
+	mu        sync.RWMutex
+	pipelines []*targetPipeline
 }
 
-func NewRedisBroker(redis *redis.Client, milvus []*MilvusClient) *RedisBroker {
+func NewRedisBroker(redis *redis.Client, milvus []IMilvusClientInterface, opts ...Option) *RedisBroker {
 	redisCli := NewRedisClient(redis)
+	o := newOptions(opts...)
+	if o.checkpoint == nil {
+		o.checkpoint = NewRedisCheckpointStore(redis, DefaultCheckpointKey)
+	}
 
-	return &RedisBroker{
-		sig:      make(chan os.Signal, 1),
+	rb := &RedisBroker{
 		redisCli: redisCli,
-		milvus:   milvus,
+		syncer:   newSyncer(milvus, o),
+		opts:     o,
 	}
+
+	rb.lifecycle = newLifecycle()
+
+	return rb
 }
 
 func (rb *RedisBroker) Start(channel, pattern string) error {
+	var start func(channel string) error
 	switch pattern {
 	case PubSub:
-		return rb.pubSub(channel)
+		start = rb.pubSub
 	case Queue:
-		return rb.queue(channel)
+		start = rb.queue
+	case Stream:
+		start = rb.stream
+	default:
+		return fmt.Errorf("pattern is invalid")
 	}
 
-	return fmt.Errorf("pattern is invalid")
+	rb.lifecycle.start()
+
+	if rb.opts.metrics != nil {
+		rb.lifecycle.spawn(func() {
+			report(rb.lifecycle.consuming(), rb.opts.metricsPeriod, func(ctx context.Context) {
+				rb.refreshGauges(ctx, channel, pattern)
+			})
+		})
+	}
+
+	return start(channel)
 }
 
-func (rb *RedisBroker) Stop() {
-	rb.sig <- syscall.SIGKILL
+// Stop stops consuming new messages and waits until ctx is done for the messages in flight, the connections stay open
+// for the caller to close or to Start again. Messages left unprocessed stay in the processing queues of the queue pattern and pending in the
+// stream pattern so they are applied after a restart, in the pub-sub pattern they are lost
+func (rb *RedisBroker) Stop(ctx context.Context) error {
+	return rb.lifecycle.stop(ctx)
+}
+
+// Health pings Redis and checks every target, the lag of the targets is only known in the queue pattern
+func (rb *RedisBroker) Health(ctx context.Context) BrokerHealth {
+	health := BrokerHealth{
+		Broker:  Redis,
+		Alive:   rb.lifecycle.alive(),
+		Targets: rb.syncer.health(ctx),
+	}
+
+	err := rb.redisCli.Ping(ctx)
+	if err != nil {
+		health.Error = fmt.Sprintf("ping redis is failed with err %v", err)
+	}
+
+	for _, status := range rb.TargetStatuses() {
+		health.Targets[status.Target].Lag = status.Lag
+	}
+
+	return health
 }
 
 func (rb *RedisBroker) pubSub(channel string) error {
-	ctx, cancelFunc := context.WithCancel(context.Background())
-	for i := 0; i < len(rb.milvus); i++ {
-		go func(idx int) {
-			subscriber := rb.redisCli.Subscribe(ctx, channel)
+	for _, target := range rb.syncer.targets() {
+		idx := target
+		rb.lifecycle.spawn(func() {
+			subscriber := rb.redisCli.Subscribe(rb.lifecycle.consuming(), channel)
+			defer subscriber.Close()
+
+			// messages of one collection are applied in order while other collections are applied in parallel
+			executor := newPartitionedExecutor(rb.lifecycle.work(), rb.opts.partitions, rb.opts.batchSize, rb.opts.batchLatency, identity, func(payloads []string) {
+				rb.handleBatch(rb.lifecycle.work(), payloads, []int{idx})
+				rb.lifecycle.end(len(payloads))
+			})
+			// the lanes drain the messages they already accepted once consuming stops
+			defer executor.close()
+
+			messages := subscriber.Channel()
 			for {
-				message, err := subscriber.ReceiveMessage(ctx)
-				if err != nil {
+				select {
+				case <-rb.lifecycle.consuming().Done():
 					return
-				}
+				case message, ok := <-messages:
+					if !ok {
+						return
+					}
 
-				errHandle := rb.handle(message.Payload, idx)
-				if errHandle != nil {
-					logrus.Errorf("handle message is failed with input %v and err %v", message, errHandle)
-					continue
-				}
+					// every target subscribes on its own, so the messages are recorded by the first one only
+					if idx == 0 {
+						rb.syncer.record(message.Payload)
+					}
+
+					rb.syncer.received([]string{message.Payload}, []int{idx})
 
-				logrus.Infof("handle message is successfully with input %v", message)
+					rb.lifecycle.begin(1)
+					if !executor.submit(rb.lifecycle.work(), message.Payload) {
+						rb.lifecycle.end(1)
+					}
+				}
 			}
-		}(i)
+		})
 	}
 
-	<-rb.sig
-	cancelFunc()
+	rb.lifecycle.wait()
 
 	return nil
 }
 
 func (rb *RedisBroker) queue(channel string) error {
-	ctx, cancelFunc := context.WithCancel(context.Background())
-	go func() {
-		for {
-			// using BRPop will wait with a timeout if the queue is empty. If timeout is 0 it will wait forever
-			message, err := rb.redisCli.BRPop(ctx, channel, 0)
+	var (
+		pipelines = make([]*targetPipeline, 0, len(rb.syncer.milvus))
+		queues    = make([]string, 0, len(rb.syncer.milvus))
+	)
+
+	for _, target := range rb.syncer.targets() {
+		pipeline := newTargetPipeline(target, channel, rb.redisCli, rb.syncer, rb.opts)
+		pipelines = append(pipelines, pipeline)
+		queues = append(queues, pipeline.queue)
+		rb.lifecycle.spawn(func() {
+			pipeline.run(rb.lifecycle)
+		})
+	}
+
+	rb.mu.Lock()
+	rb.pipelines = pipelines
+	rb.mu.Unlock()
+
+	rb.lifecycle.spawn(func() {
+		ctx := rb.lifecycle.work()
+		for !rb.lifecycle.stopping() {
+			// BRPop waits at most readBlock so stopping is checked between pops, a pop is never aborted halfway
+			message, err := rb.redisCli.BRPop(ctx, channel, rb.opts.readBlock)
 			if err != nil {
-				return
+				if err == redis.Nil {
+					continue
+				}
+
+				if ctx.Err() != nil {
+					return
+				}
+
+				logrus.Errorf("pop queue %v is failed with err %v", channel, err)
+				sleep(rb.lifecycle.consuming(), rb.opts.retryDelay)
+				continue
 			}
 
 			if len(message) < 2 {
 				continue
 			}
 
-			var wg sync.WaitGroup
-			for i := 0; i < len(rb.milvus); i++ {
-				wg.Add(1)
-				go func(idx int) {
-					defer wg.Done()
-					errHandle := rb.handle(message[1], idx)
-					if errHandle != nil {
-						logrus.Errorf("handle message is failed with input %v and err %v", message, errHandle)
-					} else {
-						logrus.Infof("handle message is successfully with input %v", message)
-					}
-				}(i)
+			rb.lifecycle.begin(1)
+			rb.syncer.record(message[1])
+			rb.syncer.received(message[1:], rb.syncer.targets())
+
+			// every target has its own queue and applies the message at its own pace
+			full, err := rb.redisCli.LPushAll(ctx, queues, message[1], rb.opts.maxQueueLength)
+			if err != nil {
+				logrus.Errorf("fan out message %v is failed with err %v", message[1], err)
+				_, errPush := rb.redisCli.RPush(context.Background(), channel, message[1])
+				if errPush != nil {
+					logrus.Errorf("push back message %v is failed with err %v", message[1], errPush)
+				}
+			}
+
+			// a target that stopped applying its queue is not fed any more, so its queue cannot grow without bound
+			for _, idx := range full {
+				errFull := fmt.Errorf("the queue %v of target %v is full", queues[idx], idx)
+				if !rb.syncer.bury(message[1], idx, errFull, 0) {
+					logrus.Errorf("drop message %v with err %v", message[1], errFull)
+				}
 			}
 
-			wg.Wait()
+			rb.lifecycle.end(1)
 		}
-	}()
+	})
 
-	<-rb.sig
-	cancelFunc()
+	rb.lifecycle.wait()
 
 	return nil
 }
 
-func (rb *RedisBroker) handle(msg string, idx int) error {
-	var message MessageCDC
+// refreshGauges reads the gauges that are not updated while messages flow, the depth of the queue or the stream group,
+// the lag of the targets in the stream pattern and the number of dead letters
+func (rb *RedisBroker) refreshGauges(ctx context.Context, channel, pattern string) {
+	switch pattern {
+	case Queue:
+		depth, err := rb.redisCli.LLen(ctx, channel)
+		if err == nil {
+			rb.opts.metrics.setQueueDepth(channel, depth)
+		}
+	case Stream:
+		// every target applies an entry before it is acked, so they all lag by the entries not read or not acked yet
+		lag, pending, err := rb.redisCli.XInfoGroup(ctx, channel, rb.opts.consumerGroup)
+		if err != nil {
+			logrus.Errorf("read group %v of stream %v is failed with err %v", rb.opts.consumerGroup, channel, err)
+			break
+		}
 
-	err := json.Unmarshal([]byte(msg), &message)
-	if err != nil {
-		return err
+		if lag < 0 {
+			break
+		}
+
+		rb.opts.metrics.setQueueDepth(channel, lag)
+		for _, target := range rb.syncer.targets() {
+			rb.opts.metrics.setLag(rb.syncer.targetId(target), lag+pending)
+		}
 	}
 
-	return rb.sync(&message, idx)
+	count, err := rb.syncer.deadLetters(ctx)
+	if err == nil {
+		rb.opts.metrics.setDeadLetters(count)
+	}
 }
 
-func (rb *RedisBroker) sync(message *MessageCDC, idx int) error {
-	if message == nil {
-		return fmt.Errorf("message cdc not found")
+// TargetStatuses reports the health, catch up mode and lag of every target of the queue pattern
+func (rb *RedisBroker) TargetStatuses() []TargetStatus {
+	rb.mu.RLock()
+	defer rb.mu.RUnlock()
+
+	statuses := make([]TargetStatus, 0, len(rb.pipelines))
+	for _, pipeline := range rb.pipelines {
+		statuses = append(statuses, pipeline.Status())
 	}
 
-	if len(rb.milvus) <= idx {
-		return fmt.Errorf("milvus client not found")
+	return statuses
+}
+
+func (rb *RedisBroker) handleBatch(ctx context.Context, payloads []string, targets []int) {
+	_, invalid := rb.syncer.handleBatch(ctx, payloads, targets)
+	for i, err := range invalid {
+		if err != nil {
+			logrus.Errorf("handle message is failed with input %v and err %v", payloads[i], err)
+		}
 	}
+}
 
-	switch message.Action {
-	case Insert:
-		return rb.insert(message, idx)
-	case Delete:
-		return rb.delete(message, idx)
-	case CreateCollection:
-		return rb.createCollection(message, idx)
-	case DropCollection:
-		return rb.dropCollection(message, idx)
-	case CreatePartition:
-		return rb.createPartition(message, idx)
-	case DropPartition:
-		return rb.dropPartition(message, idx)
-	case CreateIndex:
-		return rb.createIndex(message, idx)
-	case DropIndex:
-		return rb.dropIndex(message, idx)
+func (rb *RedisBroker) stream(channel string) error {
+	ctx := rb.lifecycle.work()
+
+	err := rb.redisCli.XGroupCreateMkStream(ctx, channel, rb.opts.consumerGroup, "0")
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return err
 	}
 
-	return fmt.Errorf("the action is invalid")
-}
+	rb.lifecycle.spawn(func() {
+		// entries left pending by a crashed or failed consumer are reclaimed on start and then periodically
+		rb.claim(ctx, channel)
 
-func (rb *RedisBroker) insert(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].Insert(cdc.Vector, cdc.CollectionName, cdc.PartitionTag, cdc.Id)
-}
+		ticker := time.NewTicker(rb.opts.claimMinIdle)
+		defer ticker.Stop()
 
-func (rb *RedisBroker) delete(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].Delete(cdc.CollectionName, cdc.PartitionTag, cdc.Id)
-}
+		for {
+			select {
+			case <-rb.lifecycle.consuming().Done():
+				return
+			case <-ticker.C:
+				rb.claim(ctx, channel)
+			default:
+			}
 
-func (rb *RedisBroker) dropCollection(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].DropCollection(cdc.CollectionName)
-}
+			// aborting a read is safe, entries already delivered stay pending and are reclaimed after a restart
+			streams, err := rb.redisCli.XReadGroup(rb.lifecycle.consuming(), &redis.XReadGroupArgs{
+				Group:    rb.opts.consumerGroup,
+				Consumer: rb.opts.consumerName,
+				Streams:  []string{channel, ">"},
+				Count:    rb.opts.readCount,
+				Block:    rb.opts.readBlock,
+			})
+			if err != nil {
+				if err == redis.Nil {
+					continue
+				}
+
+				if rb.lifecycle.stopping() {
+					return
+				}
+
+				logrus.Errorf("read stream %v is failed with err %v", channel, err)
+				sleep(rb.lifecycle.consuming(), rb.opts.readBlock)
+				continue
+			}
+
+			for _, stream := range streams {
+				rb.handleStream(ctx, channel, stream.Messages, nil)
+			}
+		}
+	})
+
+	rb.lifecycle.wait()
 
-func (rb *RedisBroker) createCollection(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].CreateCollection(cdc.CollectionName, cdc.Dimension, cdc.IndexFileSize, cdc.MetricType)
+	return nil
 }
 
-func (rb *RedisBroker) createIndex(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].CreateIndex(cdc.CollectionName, cdc.NList, cdc.IndexType)
+func (rb *RedisBroker) claim(ctx context.Context, channel string) {
+	start := "0-0"
+	for !rb.lifecycle.stopping() {
+		messages, next, err := rb.redisCli.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+			Stream:   channel,
+			Group:    rb.opts.consumerGroup,
+			Consumer: rb.opts.consumerName,
+			MinIdle:  rb.opts.claimMinIdle,
+			Start:    start,
+			Count:    rb.opts.readCount,
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				logrus.Errorf("claim pending entries of stream %v is failed with err %v", channel, err)
+			}
+
+			return
+		}
+
+		if len(messages) > 0 {
+			rb.handleStream(ctx, channel, messages, rb.deliveries(ctx, channel, messages))
+		}
+
+		if next == "0-0" || next == "" {
+			return
+		}
+
+		start = next
+	}
 }
 
-func (rb *RedisBroker) dropIndex(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].DropIndex(cdc.CollectionName)
+// deliveries reads how many times the claimed entries were delivered, an entry whose count cannot be read counts as
+// delivered once so it is not buried early
+func (rb *RedisBroker) deliveries(ctx context.Context, channel string, messages []redis.XMessage) map[string]int {
+	pending, err := rb.redisCli.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: channel,
+		Group:  rb.opts.consumerGroup,
+		Start:  messages[0].ID,
+		End:    messages[len(messages)-1].ID,
+		Count:  int64(len(messages)),
+	})
+	if err != nil {
+		logrus.Errorf("read deliveries of stream %v is failed with err %v", channel, err)
+		return nil
+	}
+
+	deliveries := make(map[string]int, len(pending))
+	for _, entry := range pending {
+		deliveries[entry.ID] = int(entry.RetryCount)
+	}
+
+	return deliveries
 }
 
-func (rb *RedisBroker) createPartition(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].CreatePartition(cdc.CollectionName, cdc.PartitionTag)
+// handleStream applies the entries and acknowledges those every target applied, deliveries holds the delivery
+// counts of claimed entries and entries missing from it are on their first delivery
+func (rb *RedisBroker) handleStream(ctx context.Context, channel string, messages []redis.XMessage, deliveries map[string]int) {
+	rb.lifecycle.begin(len(messages))
+	defer rb.lifecycle.end(len(messages))
+
+	var (
+		ids       = make([]string, 0, len(messages))
+		payloads  = make([]string, 0, len(messages))
+		delivered = make([]int, 0, len(messages))
+		fresh     = make([]string, 0, len(messages))
+	)
+
+	for _, message := range messages {
+		payload, ok := message.Values[StreamPayloadField].(string)
+		if !ok {
+			logrus.Errorf("stream entry %v has no %v field, acknowledge and skip it", message.ID, StreamPayloadField)
+			rb.ack(ctx, channel, message.ID)
+			continue
+		}
+
+		ids = append(ids, message.ID)
+		payloads = append(payloads, payload)
+		delivered = append(delivered, max(deliveries[message.ID], 1))
+
+		// a reclaimed entry was counted when it was read the first time
+		if delivered[len(delivered)-1] == 1 {
+			fresh = append(fresh, payload)
+		}
+	}
+
+	// reclaimed entries are recorded again and skipped by their event id and sequence
+	rb.syncer.record(payloads...)
+	rb.syncer.received(fresh, rb.syncer.targets())
+
+	failed, invalid := rb.syncer.handleDelivered(ctx, payloads, delivered, rb.syncer.targets())
+	for i, id := range ids {
+		if invalid[i] != nil {
+			logrus.Errorf("stream entry %v is invalid with err %v, acknowledge and skip it", id, invalid[i])
+		} else if len(failed[i]) > 0 {
+			// the entry stays pending so it is reclaimed later if any target did not apply it
+			continue
+		}
+
+		rb.ack(ctx, channel, id)
+	}
 }
 
-func (rb *RedisBroker) dropPartition(cdc *MessageCDC, idx int) error {
-	return rb.milvus[idx].DropPartition(cdc.CollectionName, cdc.PartitionTag)
+func (rb *RedisBroker) ack(ctx context.Context, channel, id string) {
+	_, err := rb.redisCli.XAck(ctx, channel, rb.opts.consumerGroup, id)
+	if err != nil {
+		logrus.Errorf("ack stream entry %v is failed with err %v", id, err)
+	}
 }
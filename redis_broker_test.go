@@ -0,0 +1,404 @@
+package milvus_cdc
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+func streamOptions(opts ...Option) []Option {
+	return append([]Option{
+		WithReadBlock(20 * time.Millisecond),
+		WithClaimMinIdle(100 * time.Millisecond),
+	}, opts...)
+}
+
+func xadd(t *testing.T, client *redis.Client, stream, payload string) string {
+	t.Helper()
+
+	id, err := client.XAdd(context.Background(), &redis.XAddArgs{
+		Stream: stream,
+		Values: map[string]interface{}{StreamPayloadField: payload},
+	}).Result()
+	if err != nil {
+		t.Fatalf("xadd is failed with err %v", err)
+	}
+
+	return id
+}
+
+func pending(t *testing.T, client *redis.Client, stream string) int64 {
+	t.Helper()
+
+	summary, err := client.XPending(context.Background(), stream, DefaultConsumerGroup).Result()
+	if err != nil {
+		return -1
+	}
+
+	return summary.Count
+}
+
+func TestRedisBrokerStreamAcksAfterEveryTarget(t *testing.T) {
+	_, client := newTestRedis(t)
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+
+	broker := NewRedisBroker(client, []IMilvusClientInterface{first, second}, streamOptions()...)
+	startBroker(t, broker, "cdc", Stream)
+
+	xadd(t, client, "cdc", insertPayload(t, "c", 1, 0))
+	xadd(t, client, "cdc", `{"action":"unknown"}`)
+
+	eventually(t, func() bool {
+		return len(first.Calls()) == 1 && len(second.Calls()) == 1 && pending(t, client, "cdc") == 0
+	}, "the entry is not applied to both targets and acknowledged")
+}
+
+func TestRedisBrokerStreamReclaimsFailedEntries(t *testing.T) {
+	_, client := newTestRedis(t)
+	target := &fakeMilvus{}
+	target.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	broker := NewRedisBroker(client, []IMilvusClientInterface{target}, streamOptions()...)
+	startBroker(t, broker, "cdc", Stream)
+
+	xadd(t, client, "cdc", insertPayload(t, "c", 1, 0))
+
+	eventually(t, func() bool {
+		return pending(t, client, "cdc") == 1
+	}, "the failed entry is not kept pending")
+
+	target.setFail(nil)
+
+	eventually(t, func() bool {
+		return slices.Equal(target.Ids(), []int64{1}) && pending(t, client, "cdc") == 0
+	}, "the pending entry is not reclaimed, applied %v", target.Ids())
+}
+
+func TestRedisBrokerStreamClaimsEntriesOfCrashedConsumer(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx := context.Background()
+
+	err := client.XGroupCreateMkStream(ctx, "cdc", DefaultConsumerGroup, "0").Err()
+	if err != nil {
+		t.Fatalf("create group is failed with err %v", err)
+	}
+
+	xadd(t, client, "cdc", insertPayload(t, "c", 7, 0))
+
+	// a consumer read the entry and crashed before acknowledging it
+	_, err = client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    DefaultConsumerGroup,
+		Consumer: "crashed",
+		Streams:  []string{"cdc", ">"},
+	}).Result()
+	if err != nil {
+		t.Fatalf("read group is failed with err %v", err)
+	}
+
+	target := &fakeMilvus{}
+	broker := NewRedisBroker(client, []IMilvusClientInterface{target}, streamOptions()...)
+	startBroker(t, broker, "cdc", Stream)
+
+	eventually(t, func() bool {
+		return slices.Equal(target.Ids(), []int64{7}) && pending(t, client, "cdc") == 0
+	}, "the entry of the crashed consumer is not claimed, applied %v", target.Ids())
+}
+
+func TestRedisBrokerStreamBuriesAfterMaxDeliver(t *testing.T) {
+	_, client := newTestRedis(t)
+	var attempts atomic.Int32
+	target := &fakeMilvus{}
+	target.setFail(func(fakeCall) error {
+		attempts.Add(1)
+		return errUnavailable
+	})
+
+	sink := NewRedisDeadLetterSink(client, "dlq")
+	broker := NewRedisBroker(client, []IMilvusClientInterface{target},
+		streamOptions(WithDeadLetterSink(sink), WithMaxDeliver(2))...)
+	startBroker(t, broker, "cdc", Stream)
+
+	xadd(t, client, "cdc", insertPayload(t, "c", 1, 0))
+
+	eventually(t, func() bool {
+		return attempts.Load() == 1
+	}, "the entry is not delivered")
+
+	if size, _ := sink.Len(context.Background()); size != 0 || pending(t, client, "cdc") != 1 {
+		t.Fatalf("the entry is buried on its first delivery")
+	}
+
+	eventually(t, func() bool {
+		size, _ := sink.Len(context.Background())
+		return size == 1 && pending(t, client, "cdc") == 0
+	}, "the entry is not buried after it was reclaimed")
+
+	letters, err := sink.List(context.Background(), "-", 10)
+	if err != nil {
+		t.Fatalf("list dead letters is failed with err %v", err)
+	}
+
+	if len(letters) != 1 || letters[0].Attempts != 2 {
+		t.Fatalf("the dead letters are %v, wanted one with 2 attempts", letters)
+	}
+}
+
+func TestRedisBrokerQueueKeepsOrderAfterATransientFailure(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx := context.Background()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	target := &fakeMilvus{}
+	target.setFail(func(call fakeCall) error {
+		if call.CollectionName == "c" && failing.Load() {
+			return errUnavailable
+		}
+
+		return nil
+	})
+
+	// both messages are already queued for the target so they are popped as one batch
+	queue := "cdc:target:0"
+	for _, message := range []string{insertPayload(t, "c", 1, 0), insertPayload(t, "d", 2, 0)} {
+		err := client.LPush(ctx, queue, message).Err()
+		if err != nil {
+			t.Fatalf("lpush is failed with err %v", err)
+		}
+	}
+
+	broker := NewRedisBroker(client, []IMilvusClientInterface{target},
+		WithReadBlock(20*time.Millisecond), WithRetryDelay(20*time.Millisecond), WithBatching(2, time.Millisecond))
+	startBroker(t, broker, "cdc", Queue)
+
+	time.Sleep(100 * time.Millisecond)
+	if ids := target.Ids(); len(ids) > 0 {
+		t.Fatalf("the ids %v are applied before the failed message", ids)
+	}
+
+	failing.Store(false)
+
+	eventually(t, func() bool {
+		return slices.Equal(target.Ids(), []int64{1, 2})
+	}, "the messages are applied as %v, wanted [1 2]", target.Ids())
+}
+
+func TestRedisBrokerQueuesFollowTheirTargetWhenTheTargetsAreReordered(t *testing.T) {
+	_, client := newTestRedis(t)
+
+	// the message was queued for the replica while it was the first target
+	err := client.LPush(context.Background(), "cdc:target:replica", insertPayload(t, "c", 1, 0)).Err()
+	if err != nil {
+		t.Fatalf("lpush is failed with err %v", err)
+	}
+
+	primary, replica := &fakeMilvus{}, &fakeMilvus{}
+	broker := NewRedisBroker(client, []IMilvusClientInterface{primary, replica}, WithReadBlock(20*time.Millisecond),
+		WithTargetName(0, "primary"), WithTargetName(1, "replica"))
+	startBroker(t, broker, "cdc", Queue)
+
+	eventually(t, func() bool {
+		return slices.Equal(replica.Ids(), []int64{1})
+	}, "the replica applied %v, wanted [1]", replica.Ids())
+
+	if ids := primary.Ids(); len(ids) > 0 {
+		t.Fatalf("the message of the replica is applied to the primary: %v", ids)
+	}
+}
+
+func TestRedisClientLPushAllSkipsFullQueues(t *testing.T) {
+	_, client := newTestRedis(t)
+	ctx := context.Background()
+	redisCli := NewRedisClient(client)
+
+	err := client.LPush(ctx, "full", "a").Err()
+	if err != nil {
+		t.Fatalf("lpush is failed with err %v", err)
+	}
+
+	full, err := redisCli.LPushAll(ctx, []string{"empty", "full"}, "b", 1)
+	if err != nil {
+		t.Fatalf("lpush all is failed with err %v", err)
+	}
+
+	if !slices.Equal(full, []int{1}) || client.LLen(ctx, "empty").Val() != 1 || client.LLen(ctx, "full").Val() != 1 {
+		t.Fatalf("the full queues are %v", full)
+	}
+}
+
+func TestRedisBrokerStreamRestartsAfterStop(t *testing.T) {
+	_, client := newTestRedis(t)
+	target := &fakeMilvus{}
+
+	broker := NewRedisBroker(client, []IMilvusClientInterface{target}, streamOptions()...)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- broker.Start("cdc", Stream)
+	}()
+
+	xadd(t, client, "cdc", insertPayload(t, "c", 1, 0))
+	eventually(t, func() bool {
+		return slices.Equal(target.Ids(), []int64{1})
+	}, "the entry is not applied before stop, applied %v", target.Ids())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := broker.Stop(ctx)
+	if err != nil {
+		t.Fatalf("stop broker is failed with err %v", err)
+	}
+
+	err = <-done
+	if err != nil {
+		t.Fatalf("start broker is failed with err %v", err)
+	}
+
+	// the connections belong to the caller
+	err = client.Ping(context.Background()).Err()
+	if err != nil {
+		t.Fatalf("the redis client is closed by stop, ping is failed with err %v", err)
+	}
+
+	if target.closed {
+		t.Fatalf("the milvus client is closed by stop")
+	}
+
+	startBroker(t, broker, "cdc", Stream)
+
+	xadd(t, client, "cdc", insertPayload(t, "c", 2, 0))
+	eventually(t, func() bool {
+		return slices.Equal(target.Ids(), []int64{1, 2}) && pending(t, client, "cdc") == 0
+	}, "the entry is not applied after a restart, applied %v", target.Ids())
+}
+
+func TestRedisClientXInfoGroup(t *testing.T) {
+	_, client := newTestRedis(t)
+	redisCli := NewRedisClient(client)
+	ctx := context.Background()
+
+	err := redisCli.XGroupCreateMkStream(ctx, "cdc", DefaultConsumerGroup, "0")
+	if err != nil {
+		t.Fatalf("create group is failed with err %v", err)
+	}
+
+	xadd(t, client, "cdc", "first")
+	xadd(t, client, "cdc", "second")
+
+	lag, pending, err := redisCli.XInfoGroup(ctx, "cdc", DefaultConsumerGroup)
+	if err != nil || lag != 2 || pending != 0 {
+		t.Fatalf("the group has lag %d and %d pending with err %v, wanted lag 2 and 0 pending", lag, pending, err)
+	}
+
+	_, err = redisCli.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    DefaultConsumerGroup,
+		Consumer: "consumer",
+		Streams:  []string{"cdc", ">"},
+		Count:    1,
+	})
+	if err != nil {
+		t.Fatalf("read group is failed with err %v", err)
+	}
+
+	_, pending, err = redisCli.XInfoGroup(ctx, "cdc", DefaultConsumerGroup)
+	if err != nil || pending != 1 {
+		t.Fatalf("the group has %d pending with err %v, wanted 1", pending, err)
+	}
+
+	_, _, err = redisCli.XInfoGroup(ctx, "cdc", "unknown")
+	if err == nil {
+		t.Fatalf("an unknown group is reported")
+	}
+}
+
+func TestRedisBrokerStreamReportsGauges(t *testing.T) {
+	_, client := newTestRedis(t)
+	sink := NewRedisDeadLetterSink(client, "")
+
+	_, err := sink.Put(context.Background(), &DeadLetter{Payload: "{", Target: 0})
+	if err != nil {
+		t.Fatalf("put dead letter is failed with err %v", err)
+	}
+
+	metrics := NewMetrics()
+	broker := NewRedisBroker(client, []IMilvusClientInterface{&fakeMilvus{}},
+		streamOptions(WithMetrics(metrics), WithMetricsInterval(10*time.Millisecond), WithDeadLetterSink(sink))...)
+	startBroker(t, broker, "cdc", Stream)
+
+	eventually(t, func() bool {
+		return gauge(t, metrics, "dead_letters", "") == 1 && gauge(t, metrics, "queue_depth", "cdc") >= 0 &&
+			gauge(t, metrics, "target_lag", "0") >= 0
+	}, "the gauges of the stream are not reported")
+}
+
+func TestRedisBrokerQueueRecordsEveryMessageOnceBeforeTheFanOut(t *testing.T) {
+	_, client := newTestRedis(t)
+	first, second := &fakeMilvus{}, &fakeMilvus{}
+	first.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	log := newTestEventLog(t, t.TempDir())
+	broker := NewRedisBroker(client, []IMilvusClientInterface{first, second},
+		WithReadBlock(20*time.Millisecond), WithRetryDelay(20*time.Millisecond), WithEventLog(log))
+	startBroker(t, broker, "cdc", Queue)
+
+	for id := int64(1); id <= 3; id++ {
+		err := client.LPush(context.Background(), "cdc", insertPayload(t, "c", id, 0)).Err()
+		if err != nil {
+			t.Fatalf("lpush is failed with err %v", err)
+		}
+	}
+
+	eventually(t, func() bool {
+		return len(second.Ids()) == 3
+	}, "the messages are not applied, applied %v", second.Ids())
+
+	// the messages the first target failed are recorded all the same, and once for both targets
+	if read := payloads(t, log, 1, 10); len(read) != 3 {
+		t.Fatalf("%d entries are recorded for 3 messages", len(read))
+	}
+}
+
+func TestRedisBrokerStreamRecordsAReclaimedEntryOnce(t *testing.T) {
+	_, client := newTestRedis(t)
+	target := &fakeMilvus{}
+	target.setFail(func(fakeCall) error {
+		return errUnavailable
+	})
+
+	log := newTestEventLog(t, t.TempDir())
+	broker := NewRedisBroker(client, []IMilvusClientInterface{target}, streamOptions(WithEventLog(log))...)
+	startBroker(t, broker, "cdc", Stream)
+
+	xadd(t, client, "cdc", payload(t, &MessageCDC{
+		Action:         Insert,
+		CollectionName: "c",
+		Id:             1,
+		Vector:         EncodeVector([]float32{1}),
+		EventId:        "e1",
+		Sequence:       1,
+	}))
+
+	eventually(t, func() bool {
+		return pending(t, client, "cdc") == 1
+	}, "the failed entry is not kept pending")
+
+	target.setFail(nil)
+
+	eventually(t, func() bool {
+		return slices.Equal(target.Ids(), []int64{1}) && pending(t, client, "cdc") == 0
+	}, "the pending entry is not reclaimed, applied %v", target.Ids())
+
+	entries, err := log.Read(context.Background(), 1, 10)
+	if err != nil || len(entries) != 1 || entries[0].EventId != "e1" || entries[0].Sequence != 1 {
+		t.Fatalf("the recorded entries are %+v with err %v", entries, err)
+	}
+}
@@ -0,0 +1,46 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type RedisCheckpointStore struct {
+	redisCli *RedisClient
+	key      string
+}
+
+func NewRedisCheckpointStore(redis *redis.Client, key string) *RedisCheckpointStore {
+	if key == "" {
+		key = DefaultCheckpointKey
+	}
+
+	return &RedisCheckpointStore{
+		redisCli: NewRedisClient(redis),
+		key:      key,
+	}
+}
+
+func (rs *RedisCheckpointStore) Get(ctx context.Context, target string, collectionName string) (int64, error) {
+	value, err := rs.redisCli.HGet(ctx, rs.targetKey(target), collectionName)
+	if err == redis.Nil {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func (rs *RedisCheckpointStore) Set(ctx context.Context, target string, collectionName string, sequence int64) error {
+	return rs.redisCli.HSetMax(ctx, rs.targetKey(target), collectionName, sequence)
+}
+
+func (rs *RedisCheckpointStore) targetKey(target string) string {
+	return fmt.Sprintf("%s:%s", rs.key, target)
+}
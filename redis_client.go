@@ -2,11 +2,35 @@ package milvus_cdc
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-redis/redis/v8"
 )
 
+// hSetMaxScript only moves the field forward, so concurrent workers can never lower it
+var hSetMaxScript = redis.NewScript(`
+local current = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0')
+if tonumber(ARGV[2]) > current then
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// lPushBoundedScript pushes the value to every queue shorter than ARGV[2] and returns the indexes of the full queues
+var lPushBoundedScript = redis.NewScript(`
+local full = {}
+for i, queue in ipairs(KEYS) do
+	if redis.call('LLEN', queue) < tonumber(ARGV[2]) then
+		redis.call('LPUSH', queue, ARGV[1])
+	else
+		table.insert(full, i - 1)
+	end
+end
+return full
+`)
+
 type RedisClient struct {
 	redis *redis.Client
 }
@@ -32,3 +56,209 @@ func (r *RedisClient) LPush(ctx context.Context, queue string, value interface{}
 func (r *RedisClient) BRPop(ctx context.Context, queue string, timeout time.Duration) ([]string, error) {
 	return r.redis.BRPop(ctx, timeout, queue).Result()
 }
+
+func (r *RedisClient) XAdd(ctx context.Context, stream string, values map[string]interface{}) (string, error) {
+	return r.redis.XAdd(ctx, &redis.XAddArgs{
+		Stream: stream,
+		Values: values,
+	}).Result()
+}
+
+func (r *RedisClient) XGroupCreateMkStream(ctx context.Context, stream, group, start string) error {
+	return r.redis.XGroupCreateMkStream(ctx, stream, group, start).Err()
+}
+
+func (r *RedisClient) XReadGroup(ctx context.Context, args *redis.XReadGroupArgs) ([]redis.XStream, error) {
+	return r.redis.XReadGroup(ctx, args).Result()
+}
+
+func (r *RedisClient) XAck(ctx context.Context, stream, group string, ids ...string) (int64, error) {
+	return r.redis.XAck(ctx, stream, group, ids...).Result()
+}
+
+// XAutoClaim sends XAUTOCLAIM as a raw command, redis 7 replies with a third element listing the deleted entries which
+// the typed command of go-redis v8 rejects
+func (r *RedisClient) XAutoClaim(ctx context.Context, args *redis.XAutoClaimArgs) ([]redis.XMessage, string, error) {
+	cmdArgs := []interface{}{"XAUTOCLAIM", args.Stream, args.Group, args.Consumer, args.MinIdle.Milliseconds(), args.Start}
+	if args.Count > 0 {
+		cmdArgs = append(cmdArgs, "COUNT", args.Count)
+	}
+
+	reply, err := r.redis.Do(ctx, cmdArgs...).Slice()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(reply) < 2 {
+		return nil, "", fmt.Errorf("the xautoclaim reply has %d elements, wanted at least 2", len(reply))
+	}
+
+	next, ok := reply[0].(string)
+	if !ok {
+		return nil, "", fmt.Errorf("the xautoclaim cursor %v is invalid", reply[0])
+	}
+
+	entries, ok := reply[1].([]interface{})
+	if !ok {
+		return nil, "", fmt.Errorf("the xautoclaim entries %v are invalid", reply[1])
+	}
+
+	messages := make([]redis.XMessage, 0, len(entries))
+	for _, entry := range entries {
+		message, err := parseXMessage(entry)
+		if err != nil {
+			return nil, "", err
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, next, nil
+}
+
+// parseXMessage reads an entry of a raw stream reply, an entry deleted meanwhile has no values
+func parseXMessage(entry interface{}) (redis.XMessage, error) {
+	fields, ok := entry.([]interface{})
+	if !ok || len(fields) != 2 {
+		return redis.XMessage{}, fmt.Errorf("the stream entry %v is invalid", entry)
+	}
+
+	id, ok := fields[0].(string)
+	if !ok {
+		return redis.XMessage{}, fmt.Errorf("the stream entry id %v is invalid", fields[0])
+	}
+
+	message := redis.XMessage{
+		ID:     id,
+		Values: make(map[string]interface{}),
+	}
+
+	values, _ := fields[1].([]interface{})
+	for i := 0; i+1 < len(values); i += 2 {
+		key, ok := values[i].(string)
+		if !ok {
+			return redis.XMessage{}, fmt.Errorf("the field %v of stream entry %v is invalid", values[i], id)
+		}
+
+		message.Values[key] = values[i+1]
+	}
+
+	return message, nil
+}
+
+// XInfoGroup sends XINFO GROUPS as a raw command and returns the lag and the pending entries of the group, the typed
+// command of go-redis v8 does not read the lag. The lag is -1 when redis cannot tell it, before redis 7 or after
+// entries were deleted
+func (r *RedisClient) XInfoGroup(ctx context.Context, stream, group string) (int64, int64, error) {
+	reply, err := r.redis.Do(ctx, "XINFO", "GROUPS", stream).Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, entry := range reply {
+		fields, ok := entry.([]interface{})
+		if !ok {
+			return 0, 0, fmt.Errorf("the xinfo groups entry %v is invalid", entry)
+		}
+
+		info := make(map[string]interface{}, len(fields)/2)
+		for i := 0; i+1 < len(fields); i += 2 {
+			key, _ := fields[i].(string)
+			info[key] = fields[i+1]
+		}
+
+		if name, _ := info["name"].(string); name != group {
+			continue
+		}
+
+		pending, _ := info["pending"].(int64)
+		lag, ok := info["lag"].(int64)
+		if !ok {
+			lag = -1
+		}
+
+		return lag, pending, nil
+	}
+
+	return 0, 0, fmt.Errorf("the group %v of stream %v is not found", group, stream)
+}
+
+func (r *RedisClient) XPendingExt(ctx context.Context, args *redis.XPendingExtArgs) ([]redis.XPendingExt, error) {
+	return r.redis.XPendingExt(ctx, args).Result()
+}
+
+func (r *RedisClient) XRangeN(ctx context.Context, stream, start, stop string, count int64) ([]redis.XMessage, error) {
+	return r.redis.XRangeN(ctx, stream, start, stop, count).Result()
+}
+
+func (r *RedisClient) XRevRangeN(ctx context.Context, stream, start, stop string, count int64) ([]redis.XMessage, error) {
+	return r.redis.XRevRangeN(ctx, stream, start, stop, count).Result()
+}
+
+func (r *RedisClient) XLen(ctx context.Context, stream string) (int64, error) {
+	return r.redis.XLen(ctx, stream).Result()
+}
+
+func (r *RedisClient) XDel(ctx context.Context, stream string, ids ...string) (int64, error) {
+	return r.redis.XDel(ctx, stream, ids...).Result()
+}
+
+// LPushAll pushes the value atomically to every queue holding less than capacity values and returns the indexes of
+// the queues that are full
+func (r *RedisClient) LPushAll(ctx context.Context, queues []string, value interface{}, capacity int64) ([]int, error) {
+	full, err := lPushBoundedScript.Run(ctx, r.redis, queues, value, capacity).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make([]int, 0, len(full))
+	for _, idx := range full {
+		indexes = append(indexes, int(idx))
+	}
+
+	return indexes, nil
+}
+
+func (r *RedisClient) RPush(ctx context.Context, queue string, value interface{}) (int64, error) {
+	return r.redis.RPush(ctx, queue, value).Result()
+}
+
+func (r *RedisClient) BRPopLPush(ctx context.Context, source, destination string, timeout time.Duration) (string, error) {
+	return r.redis.BRPopLPush(ctx, source, destination, timeout).Result()
+}
+
+func (r *RedisClient) RPopLPush(ctx context.Context, source, destination string) (string, error) {
+	return r.redis.RPopLPush(ctx, source, destination).Result()
+}
+
+func (r *RedisClient) LMove(ctx context.Context, source, destination, srcPos, destPos string) (string, error) {
+	return r.redis.LMove(ctx, source, destination, srcPos, destPos).Result()
+}
+
+func (r *RedisClient) LRem(ctx context.Context, queue string, count int64, value interface{}) (int64, error) {
+	return r.redis.LRem(ctx, queue, count, value).Result()
+}
+
+func (r *RedisClient) LLen(ctx context.Context, queue string) (int64, error) {
+	return r.redis.LLen(ctx, queue).Result()
+}
+
+func (r *RedisClient) HGet(ctx context.Context, key, field string) (string, error) {
+	return r.redis.HGet(ctx, key, field).Result()
+}
+
+func (r *RedisClient) HSetMax(ctx context.Context, key, field string, value int64) error {
+	return hSetMaxScript.Run(ctx, r.redis, []string{key}, field, value).Err()
+}
+
+func (r *RedisClient) Incr(ctx context.Context, key string) (int64, error) {
+	return r.redis.Incr(ctx, key).Result()
+}
+
+func (r *RedisClient) Ping(ctx context.Context) error {
+	return r.redis.Ping(ctx).Err()
+}
+
+func (r *RedisClient) Close() error {
+	return r.redis.Close()
+}
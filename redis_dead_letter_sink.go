@@ -0,0 +1,100 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type RedisDeadLetterSink struct {
+	redisCli *RedisClient
+	key      string
+}
+
+func NewRedisDeadLetterSink(redis *redis.Client, key string) *RedisDeadLetterSink {
+	if key == "" {
+		key = DefaultDeadLetterKey
+	}
+
+	return &RedisDeadLetterSink{
+		redisCli: NewRedisClient(redis),
+		key:      key,
+	}
+}
+
+func (rs *RedisDeadLetterSink) Put(ctx context.Context, letter *DeadLetter) (string, error) {
+	marshal, err := json.Marshal(letter)
+	if err != nil {
+		return "", err
+	}
+
+	return rs.redisCli.XAdd(ctx, rs.key, map[string]interface{}{
+		StreamPayloadField: string(marshal),
+	})
+}
+
+func (rs *RedisDeadLetterSink) List(ctx context.Context, start string, count int64) ([]*DeadLetter, error) {
+	if start == "" {
+		start = "-"
+	}
+
+	messages, err := rs.redisCli.XRangeN(ctx, rs.key, start, "+", count)
+	if err != nil {
+		return nil, err
+	}
+
+	letters := make([]*DeadLetter, 0, len(messages))
+	for _, message := range messages {
+		letter, err := rs.decode(message)
+		if err != nil {
+			return nil, err
+		}
+
+		letters = append(letters, letter)
+	}
+
+	return letters, nil
+}
+
+func (rs *RedisDeadLetterSink) Get(ctx context.Context, id string) (*DeadLetter, error) {
+	messages, err := rs.redisCli.XRangeN(ctx, rs.key, id, id, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("dead letter %v not found", id)
+	}
+
+	return rs.decode(messages[0])
+}
+
+func (rs *RedisDeadLetterSink) Delete(ctx context.Context, id string) error {
+	_, err := rs.redisCli.XDel(ctx, rs.key, id)
+
+	return err
+}
+
+func (rs *RedisDeadLetterSink) Len(ctx context.Context) (int64, error) {
+	return rs.redisCli.XLen(ctx, rs.key)
+}
+
+func (rs *RedisDeadLetterSink) decode(message redis.XMessage) (*DeadLetter, error) {
+	payload, ok := message.Values[StreamPayloadField].(string)
+	if !ok {
+		return nil, fmt.Errorf("dead letter %v has no %v field", message.ID, StreamPayloadField)
+	}
+
+	var letter DeadLetter
+
+	err := json.Unmarshal([]byte(payload), &letter)
+	if err != nil {
+		return nil, err
+	}
+
+	letter.Id = message.ID
+
+	return &letter, nil
+}
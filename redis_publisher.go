@@ -0,0 +1,68 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisTransport publishes to a channel in the pub-sub pattern, pushes to a list in the queue pattern and appends to
+// a stream in the stream pattern. Only the stream pattern has a broker id, the entry id
+type RedisTransport struct {
+	redisCli *RedisClient
+	channel  string
+	pattern  string
+}
+
+func NewRedisTransport(redis *redis.Client, channel, pattern string) (*RedisTransport, error) {
+	switch pattern {
+	case PubSub, Queue, Stream:
+	default:
+		return nil, fmt.Errorf("pattern is invalid")
+	}
+
+	return &RedisTransport{
+		redisCli: NewRedisClient(redis),
+		channel:  channel,
+		pattern:  pattern,
+	}, nil
+}
+
+// NewRedisPublisher sequences the messages with a RedisSequencer on the same redis unless another sequencer is given
+func NewRedisPublisher(redis *redis.Client, channel, pattern string, opts ...PublisherOption) (*Publisher, error) {
+	transport, err := NewRedisTransport(redis, channel, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	opts = append([]PublisherOption{WithSequencer(NewRedisSequencer(redis, ""))}, opts...)
+
+	return NewPublisher(transport, opts...), nil
+}
+
+func (rt *RedisTransport) Send(ctx context.Context, message *MessageCDC, payload []byte) (string, error) {
+	switch rt.pattern {
+	case PubSub:
+		_, err := rt.redisCli.Publish(ctx, rt.channel, string(payload))
+		if err != nil {
+			return "", err
+		}
+	case Queue:
+		_, err := rt.redisCli.LPush(ctx, rt.channel, payload)
+		if err != nil {
+			return "", err
+		}
+	case Stream:
+		return rt.redisCli.XAdd(ctx, rt.channel, map[string]interface{}{
+			StreamPayloadField: payload,
+		})
+	}
+
+	return message.EventId, nil
+}
+
+// Close leaves the redis client open, it is owned by the caller
+func (rt *RedisTransport) Close() error {
+	return nil
+}
@@ -0,0 +1,30 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// RedisSequencer counts the messages of every collection with INCR, so publishers sharing the redis share the
+// sequences
+type RedisSequencer struct {
+	redisCli *RedisClient
+	key      string
+}
+
+func NewRedisSequencer(redis *redis.Client, key string) *RedisSequencer {
+	if key == "" {
+		key = DefaultSequenceKey
+	}
+
+	return &RedisSequencer{
+		redisCli: NewRedisClient(redis),
+		key:      key,
+	}
+}
+
+func (rs *RedisSequencer) Next(ctx context.Context, collectionName string) (int64, error) {
+	return rs.redisCli.Incr(ctx, fmt.Sprintf("%s:%s", rs.key, collectionName))
+}
@@ -0,0 +1,113 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Replayer applies the entries of an event log to a target, for instance to rebuild an empty replica to the state
+// the others had at a past position
+type Replayer struct {
+	log    IEventLog
+	syncer *syncer
+}
+
+// NewReplayer replays log into target with the retry policy, checkpoint store, metrics and tracer of the options. The
+// event log of the options is ignored so the replayed entries are not recorded again
+func NewReplayer(log IEventLog, target IMilvusClientInterface, opts ...Option) *Replayer {
+	o := newOptions(opts...)
+	o.eventLog = nil
+
+	return &Replayer{
+		log:    log,
+		syncer: newSyncer([]IMilvusClientInterface{target}, o),
+	}
+}
+
+// Replay applies the entries from position from to position to, both included, a to of 0 replays up to the end of
+// the log. Entries carrying an event id and sequence already replayed are skipped, so redeliveries recorded twice are
+// applied once. It stops at the first entry the target fails to apply and returns how many entries were applied
+func (r *Replayer) Replay(ctx context.Context, from, to int64) (int, error) {
+	var (
+		applied int
+		seen    = make(map[string]struct{})
+	)
+
+	for to == 0 || from <= to {
+		if ctx.Err() != nil {
+			return applied, ctx.Err()
+		}
+
+		entries, err := r.log.Read(ctx, from, DefaultReplayReadCount)
+		if err != nil {
+			return applied, err
+		}
+
+		if len(entries) == 0 {
+			break
+		}
+
+		for _, entry := range entries {
+			if to > 0 && entry.Position > to {
+				break
+			}
+
+			from = entry.Position + 1
+
+			if entry.EventId != "" {
+				key := eventKey(entry.EventId, entry.Sequence)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+
+				seen[key] = struct{}{}
+			}
+
+			r.syncer.received([]string{entry.Payload}, r.syncer.targets())
+
+			failed, err := r.syncer.handleAll(ctx, entry.Payload, r.syncer.targets())
+			if err != nil {
+				logrus.Errorf("the entry at position %d is invalid with err %v, skip it", entry.Position, err)
+				continue
+			}
+
+			if len(failed) > 0 {
+				return applied, fmt.Errorf("replay the entry at position %d is failed", entry.Position)
+			}
+
+			applied++
+		}
+	}
+
+	logrus.Infof("%d entries of the event log are replayed", applied)
+
+	return applied, nil
+}
+
+// ReplayBetween applies the entries recorded from from to to, both included, a zero to replays up to the end of the
+// log
+func (r *Replayer) ReplayBetween(ctx context.Context, from, to time.Time) (int, error) {
+	start, err := r.log.Seek(ctx, from)
+	if err != nil {
+		return 0, err
+	}
+
+	var end int64
+	if !to.IsZero() {
+		// the entries recorded at to are included, Seek returns the first one recorded after
+		end, err = r.log.Seek(ctx, to.Add(time.Nanosecond))
+		if err != nil {
+			return 0, err
+		}
+
+		end--
+		if end < start {
+			return 0, nil
+		}
+	}
+
+	return r.Replay(ctx, start, end)
+}
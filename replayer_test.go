@@ -0,0 +1,77 @@
+package milvus_cdc
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestReplayerAppliesTheEntriesBetweenTwoTimestamps(t *testing.T) {
+	log := newTestEventLog(t, t.TempDir())
+	start := time.Unix(1700000000, 0)
+
+	for id := int64(1); id <= 4; id++ {
+		_, err := log.Append(context.Background(), &LogEntry{
+			Timestamp: start.Add(time.Duration(id) * time.Second),
+			Payload:   insertPayload(t, "c", id, 0),
+		})
+		if err != nil {
+			t.Fatalf("append is failed with err %v", err)
+		}
+	}
+
+	target := &fakeMilvus{}
+	applied, err := NewReplayer(log, target).ReplayBetween(context.Background(), start.Add(2*time.Second), start.Add(3*time.Second))
+	if err != nil || applied != 2 || !slices.Equal(target.Ids(), []int64{2, 3}) {
+		t.Fatalf("%d entries are replayed with err %v, the target holds %v", applied, err, target.Ids())
+	}
+}
+
+func TestReplayerStopsAtTheFirstFailedEntry(t *testing.T) {
+	log := newTestEventLog(t, t.TempDir())
+	for id := int64(1); id <= 3; id++ {
+		_, err := log.Append(context.Background(), &LogEntry{Payload: insertPayload(t, "c", id, 0)})
+		if err != nil {
+			t.Fatalf("append is failed with err %v", err)
+		}
+	}
+
+	target := &fakeMilvus{}
+	target.setFail(func(call fakeCall) error {
+		if slices.Contains(call.Ids, 2) {
+			return &MilvusError{Op: "fake", Class: ErrorClassPermanent, Message: "rejected"}
+		}
+
+		return nil
+	})
+
+	applied, err := NewReplayer(log, target).Replay(context.Background(), 1, 0)
+	if err == nil || applied != 1 || !slices.Equal(target.Ids(), []int64{1}) {
+		t.Fatalf("%d entries are replayed with err %v, the target holds %v", applied, err, target.Ids())
+	}
+}
+
+func TestReplayerSkipsDuplicatesByEventIdAndSequence(t *testing.T) {
+	log := newTestEventLog(t, t.TempDir())
+	for _, entry := range []struct {
+		id       int64
+		sequence int64
+	}{{1, 1}, {2, 2}, {1, 1}} {
+		_, err := log.Append(context.Background(), &LogEntry{
+			EventId:  "e1",
+			Sequence: entry.sequence,
+			Payload:  insertPayload(t, "c", entry.id, entry.sequence),
+		})
+		if err != nil {
+			t.Fatalf("append is failed with err %v", err)
+		}
+	}
+
+	// the two messages of the event are replayed and the redelivered first one is skipped
+	target := &fakeMilvus{}
+	applied, err := NewReplayer(log, target).Replay(context.Background(), 1, 0)
+	if err != nil || applied != 2 || !slices.Equal(target.Ids(), []int64{1, 2}) {
+		t.Fatalf("%d entries are replayed with err %v, the target holds %v", applied, err, target.Ids())
+	}
+}
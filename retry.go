@@ -0,0 +1,77 @@
+package milvus_cdc
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Jitter randomizes every backoff by up to this fraction, 0.2 waits between 80% and 120% of the backoff
+	Jitter float64
+	// RetryOn decides per error class whether a failure is retried, see ClassifyError
+	RetryOn map[ErrorClass]bool
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    DefaultMaxAttempts,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+		Multiplier:     DefaultMultiplier,
+		Jitter:         DefaultJitter,
+		RetryOn: map[ErrorClass]bool{
+			ErrorClassTransient: true,
+		},
+	}
+}
+
+// do runs fn until it succeeds, the error is not retryable, the attempts are exhausted or ctx is done, a failure after
+// more than one attempt is wrapped in a RetryError
+func (p RetryPolicy) do(ctx context.Context, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= p.MaxAttempts || !p.RetryOn[ClassifyError(err)] {
+			return giveUp(attempt, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return giveUp(attempt, err)
+		case <-time.After(p.backoff(attempt)):
+		}
+	}
+}
+
+func giveUp(attempts int, err error) error {
+	if attempts == 1 {
+		return err
+	}
+
+	return &RetryError{
+		Attempts: attempts,
+		Err:      err,
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		backoff = backoff * (1 + p.Jitter*(2*rand.Float64()-1))
+	}
+
+	return time.Duration(backoff)
+}
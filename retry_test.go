@@ -0,0 +1,87 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus"
+	"github.com/warriors-vn/milvus-cdc/third_party/milvus-sdk-go/milvus/grpc/gen"
+)
+
+func TestRetryPolicyStopsWhenContextIsDone(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    10,
+		InitialBackoff: time.Hour,
+		Multiplier:     1,
+		RetryOn:        map[ErrorClass]bool{ErrorClassTransient: true},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	var attempts int
+	start := time.Now()
+	err := policy.do(ctx, func() error {
+		attempts++
+		return errUnavailable
+	})
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("the backoff is not interrupted, it took %v", elapsed)
+	}
+
+	if attempts != 1 || !errors.Is(err, errUnavailable) {
+		t.Fatalf("the retry ended after %d attempts with err %v", attempts, err)
+	}
+}
+
+func TestRetryPolicyRetriesTransientErrorsOnly(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Multiplier:  1,
+		RetryOn:     map[ErrorClass]bool{ErrorClassTransient: true},
+	}
+
+	var attempts int
+	err := policy.do(context.Background(), func() error {
+		attempts++
+		return errUnavailable
+	})
+
+	var retryErr *RetryError
+	if !errors.As(err, &retryErr) || retryErr.Attempts != 3 || attempts != 3 {
+		t.Fatalf("the transient error is retried %d times with err %v", attempts, err)
+	}
+
+	attempts = 0
+	err = policy.do(context.Background(), func() error {
+		attempts++
+		return errors.New("unknown")
+	})
+
+	if attempts != 1 || err == nil {
+		t.Fatalf("the unknown error is retried %d times", attempts)
+	}
+}
+
+func TestStatusErrorsAreTransientForTransientCodesAndReasons(t *testing.T) {
+	for _, tc := range []struct {
+		code    gen.ErrorCode
+		message string
+		class   ErrorClass
+	}{
+		{gen.ErrorCode_CONNECT_FAILED, "connect failed", ErrorClassTransient},
+		{gen.ErrorCode_OUT_OF_MEMORY, "out of memory", ErrorClassTransient},
+		{gen.ErrorCode_UNEXPECTED_ERROR, "Server is busy, retry later", ErrorClassTransient},
+		{gen.ErrorCode_UNEXPECTED_ERROR, "the dimension of the vector does not match the collection", ErrorClassPermanent},
+		{gen.ErrorCode_ILLEGAL_DIMENSION, "illegal dimension", ErrorClassPermanent},
+		{gen.ErrorCode_COLLECTION_NOT_EXISTS, "collection c does not exist", ErrorClassPermanent},
+	} {
+		err := newStatusError("Insert", milvus.NewStatus1(milvus.ErrorCode(tc.code), tc.message))
+		if class := ClassifyError(err); class != tc.class {
+			t.Errorf("the status %v %q is %v, wanted %v", tc.code, tc.message, class, tc.class)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package milvus_cdc
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+)
+
+// RouteRule matches messages by collection name and partition tag globs, in the syntax of path.Match, and by action.
+// An empty field matches every message. A message without a partition tag belongs to the _default partition
+type RouteRule struct {
+	Collections []string `json:"collections,omitempty"`
+	Partitions  []string `json:"partitions,omitempty"`
+	Actions     []string `json:"actions,omitempty"`
+}
+
+// Route selects the messages a target receives: the ones matching an include rule, or every message when there is
+// none, unless they match an exclude rule. The partitions of a rule only select the inserts, the deletes of a
+// partition and the partition DDL. The collection DDL and the deletes without partition tag, which remove the ids
+// from every partition, reach a target that includes any partition of the collection and are only excluded with the
+// whole collection, so a target always holds the collections of the partitions routed to it. For the same reason the
+// actions of an include rule must select the creation of the collection
+type Route struct {
+	Include []RouteRule `json:"include,omitempty"`
+	Exclude []RouteRule `json:"exclude,omitempty"`
+}
+
+func (r Route) Match(message *MessageCDC) bool {
+	if len(r.Include) > 0 && !slices.ContainsFunc(r.Include, func(rule RouteRule) bool {
+		return rule.match(message, true)
+	}) {
+		return false
+	}
+
+	return !slices.ContainsFunc(r.Exclude, func(rule RouteRule) bool {
+		return rule.match(message, false)
+	})
+}
+
+// Validate reports the globs that are malformed and the actions that do not exist
+func (r Route) Validate() error {
+	var errs []error
+	for i, rule := range r.Include {
+		errs = append(errs, rule.validate(fmt.Sprintf("include[%d]", i))...)
+
+		if len(rule.Actions) > 0 && !slices.Contains(rule.Actions, CreateCollection) {
+			errs = append(errs, fmt.Errorf("include[%d] actions do not select %q, the target would miss the collections "+
+				"of the messages it receives", i, CreateCollection))
+		}
+	}
+
+	for i, rule := range r.Exclude {
+		errs = append(errs, rule.validate(fmt.Sprintf("exclude[%d]", i))...)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r RouteRule) match(message *MessageCDC, include bool) bool {
+	if len(r.Actions) > 0 && !slices.Contains(r.Actions, message.Action) {
+		return false
+	}
+
+	if len(r.Collections) > 0 && !matchGlobs(r.Collections, message.CollectionName) {
+		return false
+	}
+
+	if len(r.Partitions) == 0 {
+		return true
+	}
+
+	if !partitionScoped(message) {
+		return include
+	}
+
+	partitionTag := message.PartitionTag
+	if partitionTag == "" {
+		partitionTag = DefaultPartitionTag
+	}
+
+	return matchGlobs(r.Partitions, partitionTag)
+}
+
+func (r RouteRule) validate(name string) []error {
+	var errs []error
+	for _, pattern := range slices.Concat(r.Collections, r.Partitions) {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s glob %q is invalid", name, pattern))
+		}
+	}
+
+	for _, action := range r.Actions {
+		if !slices.Contains(actions, action) {
+			errs = append(errs, fmt.Errorf("%s action %q is invalid", name, action))
+		}
+	}
+
+	return errs
+}
+
+var actions = []string{Insert, Delete, CreateCollection, DropCollection, CreatePartition, DropPartition, CreateIndex,
+	DropIndex, LoadCollection, ReleaseCollection}
+
+// partitionScoped reports whether the message applies to a partition rather than to the whole collection, a delete
+// without partition tag applies to every partition
+func partitionScoped(message *MessageCDC) bool {
+	switch message.Action {
+	case Insert, CreatePartition, DropPartition:
+		return true
+	case Delete:
+		return message.PartitionTag != ""
+	}
+
+	return false
+}
+
+func matchGlobs(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		matched, _ := path.Match(pattern, name)
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
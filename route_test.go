@@ -0,0 +1,109 @@
+package milvus_cdc
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRouteMatchesDDLAndDMLConsistently(t *testing.T) {
+	tenant := Route{
+		Include: []RouteRule{{Collections: []string{"tenant_*"}, Partitions: []string{"hot"}}},
+		Exclude: []RouteRule{{Collections: []string{"tenant_old"}}},
+	}
+
+	tests := []struct {
+		name    string
+		message MessageCDC
+		matched bool
+	}{
+		{name: "insert into an included partition", message: MessageCDC{Action: Insert, CollectionName: "tenant_a", PartitionTag: "hot"}, matched: true},
+		{name: "insert into another partition", message: MessageCDC{Action: Insert, CollectionName: "tenant_a", PartitionTag: "cold"}, matched: false},
+		{name: "insert into the default partition", message: MessageCDC{Action: Insert, CollectionName: "tenant_a"}, matched: false},
+		{name: "delete from another partition", message: MessageCDC{Action: Delete, CollectionName: "tenant_a", PartitionTag: "cold"}, matched: false},
+		{name: "delete without partition tag", message: MessageCDC{Action: Delete, CollectionName: "tenant_a"}, matched: true},
+		{name: "collection ddl", message: MessageCDC{Action: CreateCollection, CollectionName: "tenant_a"}, matched: true},
+		{name: "partition ddl", message: MessageCDC{Action: CreatePartition, CollectionName: "tenant_a", PartitionTag: "cold"}, matched: false},
+		{name: "another collection", message: MessageCDC{Action: CreateCollection, CollectionName: "shared"}, matched: false},
+		{name: "excluded collection", message: MessageCDC{Action: Insert, CollectionName: "tenant_old", PartitionTag: "hot"}, matched: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if matched := tenant.Match(&test.message); matched != test.matched {
+				t.Fatalf("the route matches %v, wanted %v", matched, test.matched)
+			}
+		})
+	}
+}
+
+func TestRouteExcludesAPartitionButNotTheDeletesOfTheCollection(t *testing.T) {
+	route := Route{Exclude: []RouteRule{{Partitions: []string{"archive_*"}}}}
+
+	if route.Match(&MessageCDC{Action: Insert, CollectionName: "c", PartitionTag: "archive_1"}) {
+		t.Fatalf("an insert into an excluded partition is matched")
+	}
+
+	if !route.Match(&MessageCDC{Action: Delete, CollectionName: "c"}) ||
+		!route.Match(&MessageCDC{Action: DropCollection, CollectionName: "c"}) {
+		t.Fatalf("the messages of the whole collection are excluded with a partition")
+	}
+}
+
+func TestRouteValidate(t *testing.T) {
+	route := Route{
+		Include: []RouteRule{
+			{Collections: []string{"["}, Actions: []string{CreateCollection, "upsert"}},
+			{Actions: []string{Insert, Delete}},
+			{Actions: []string{CreateCollection, Insert}},
+		},
+	}
+
+	err := route.Validate()
+	if err == nil {
+		t.Fatalf("an invalid route is valid")
+	}
+
+	for _, want := range []string{
+		`include[0] glob "[" is invalid`,
+		`include[0] action "upsert" is invalid`,
+		`include[1] actions do not select "create-collection"`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("the error does not report %q:\n%v", want, err)
+		}
+	}
+
+	if strings.Contains(err.Error(), "include[2]") {
+		t.Fatalf("a valid rule is reported:\n%v", err)
+	}
+}
+
+func TestSyncBatchFollowsTheRouteOfTheTarget(t *testing.T) {
+	target := &fakeMilvus{}
+	metrics := NewMetrics()
+	s := newSyncer([]IMilvusClientInterface{target}, newOptions(WithMetrics(metrics),
+		WithRoute(0, Route{Include: []RouteRule{{Collections: []string{"c"}, Partitions: []string{"p"}}}})))
+
+	errs := s.syncBatch(context.Background(), []*MessageCDC{
+		{Action: CreateCollection, CollectionName: "c", Dimension: 1},
+		{Action: Insert, CollectionName: "c", PartitionTag: "q", Id: 1, Vector: EncodeVector([]float32{1})},
+		{Action: Insert, CollectionName: "c", PartitionTag: "p", Id: 2, Vector: EncodeVector([]float32{2})},
+		{Action: Delete, CollectionName: "c", Id: 2},
+		{Action: Insert, CollectionName: "d", Id: 3, Vector: EncodeVector([]float32{3})},
+	}, 0)
+
+	for i, err := range errs {
+		if err != nil {
+			t.Fatalf("the message %d is failed with err %v", i, err)
+		}
+	}
+
+	if ids := target.Ids(); len(target.Calls()) != 3 || len(ids) != 2 || ids[0] != 2 || ids[1] != -2 {
+		t.Fatalf("the target received %+v", target.Calls())
+	}
+
+	if filtered := gauge(t, metrics, "messages_filtered_total", "insert"); filtered != 2 {
+		t.Fatalf("%v inserts are counted as filtered", filtered)
+	}
+}
@@ -0,0 +1,650 @@
+package milvus_cdc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type syncer struct {
+	milvus      []IMilvusClientInterface
+	batchSize   int
+	deadLetter  IDeadLetterSink
+	maxDeliver  int
+	retryPolicy RetryPolicy
+	checkpoint  ICheckpointStore
+	metrics     *Metrics
+	tracer      trace.Tracer
+	eventLog    IEventLog
+	recorded    *recordedKeys
+	routes      map[int]Route
+	mappings    map[int]NameMapping
+	names       map[int]string
+	lastApplied []atomic.Int64
+}
+
+func newSyncer(milvus []IMilvusClientInterface, opts *options) *syncer {
+	return &syncer{
+		milvus:      milvus,
+		batchSize:   opts.batchSize,
+		deadLetter:  opts.deadLetter,
+		maxDeliver:  opts.maxDeliver,
+		retryPolicy: opts.retryPolicy,
+		checkpoint:  opts.checkpoint,
+		metrics:     opts.metrics,
+		tracer:      opts.tracer(),
+		eventLog:    opts.eventLog,
+		recorded:    newRecordedKeys(DefaultRecordedKeys),
+		routes:      opts.routes,
+		mappings:    opts.mappings,
+		names:       opts.targetNames,
+		lastApplied: make([]atomic.Int64, len(milvus)),
+	}
+}
+
+// health checks the targets in parallel, a target is connected when its server status answers within ctx
+func (s *syncer) health(ctx context.Context) []TargetHealth {
+	var (
+		targets = make([]TargetHealth, len(s.milvus))
+		wg      sync.WaitGroup
+	)
+
+	for i := range s.milvus {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+
+			targets[idx] = s.checkTarget(ctx, idx)
+		}(i)
+	}
+
+	wg.Wait()
+
+	return targets
+}
+
+func (s *syncer) checkTarget(ctx context.Context, idx int) TargetHealth {
+	milvus := s.client(ctx, idx)
+	target := TargetHealth{
+		Target:    idx,
+		Connected: milvus.IsConnected(),
+	}
+
+	status, err := milvus.ServerStatus()
+	if err != nil {
+		target.Connected = false
+		target.Error = err.Error()
+	}
+
+	target.Status = status
+	if lastApplied := s.lastApplied[idx].Load(); lastApplied > 0 {
+		applied := time.Unix(0, lastApplied)
+		target.LastApplied = &applied
+	}
+
+	return target
+}
+
+// targetId is the stable identity of the target: its configured name, the address of its client or its index
+func (s *syncer) targetId(idx int) string {
+	if name, ok := s.names[idx]; ok {
+		return name
+	}
+
+	if addressed, ok := s.milvus[idx].(interface{ Address() string }); ok && addressed.Address() != "" {
+		return addressed.Address()
+	}
+
+	return strconv.Itoa(idx)
+}
+
+func (s *syncer) targets() []int {
+	targets := make([]int, 0, len(s.milvus))
+	for i := range s.milvus {
+		targets = append(targets, i)
+	}
+
+	return targets
+}
+
+// handleAll applies the payload to the given targets concurrently and returns the targets that failed,
+// an error is returned only when the payload itself is invalid so retrying it would never succeed
+func (s *syncer) handleAll(ctx context.Context, payload string, targets []int) ([]int, error) {
+	failed, invalid := s.handleBatch(ctx, []string{payload}, targets)
+
+	return failed[0], invalid[0]
+}
+
+// handleBatch applies the payloads of a broker that never redelivers them, a payload that fails is buried at once
+func (s *syncer) handleBatch(ctx context.Context, payloads []string, targets []int) ([][]int, []error) {
+	return s.handleDelivered(ctx, payloads, nil, targets)
+}
+
+// handleDelivered applies the payloads in order to the given targets concurrently and returns for every payload the
+// targets that failed, or the error that makes the payload invalid. deliveries counts how many times the broker
+// delivered every payload, a failed payload is only buried once it reached maxDeliver. The retries of a target end
+// once ctx is done
+func (s *syncer) handleDelivered(ctx context.Context, payloads []string, deliveries []int, targets []int) ([][]int, []error) {
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		failed    = make([][]int, len(payloads))
+		invalid   = make([]error, len(payloads))
+		messages  = make([]*MessageCDC, 0, len(payloads))
+		positions = make([]int, 0, len(payloads))
+	)
+
+	for i, payload := range payloads {
+		message, err := s.decode(payload)
+		if err != nil {
+			invalid[i] = err
+			continue
+		}
+
+		messages = append(messages, message)
+		positions = append(positions, i)
+	}
+
+	for _, target := range targets {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			errs := s.syncBatch(ctx, messages, idx)
+			for i, errSync := range errs {
+				payload := payloads[positions[i]]
+				if errors.Is(errSync, errSkipped) {
+					mu.Lock()
+					failed[positions[i]] = append(failed[positions[i]], idx)
+					mu.Unlock()
+					continue
+				}
+
+				if errSync != nil {
+					logrus.Errorf("handle message is failed with input %v, target %v and err %v", payload, idx, errSync)
+
+					delivered := 0
+					if deliveries != nil {
+						delivered = deliveries[positions[i]]
+					}
+
+					if s.exhausted(delivered, deliveries != nil) && s.bury(payload, idx, errSync, delivered) {
+						continue
+					}
+
+					mu.Lock()
+					failed[positions[i]] = append(failed[positions[i]], idx)
+					mu.Unlock()
+					continue
+				}
+
+				logrus.Infof("handle message is successfully with input %v and target %v", payload, idx)
+			}
+		}(target)
+	}
+
+	wg.Wait()
+
+	return failed, invalid
+}
+
+// record appends the payloads to the event log as the broker hands them over, before they are fanned out or applied.
+// A payload delivered again with the event id and sequence of one already recorded is not appended twice, invalid
+// payloads are not recorded and a payload that cannot be recorded is still applied
+func (s *syncer) record(payloads ...string) {
+	if s.eventLog == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	now := time.Now()
+	for _, payload := range payloads {
+		message, err := s.decode(payload)
+		if err != nil {
+			continue
+		}
+
+		key := ""
+		if message.EventId != "" {
+			key = eventKey(message.EventId, message.Sequence)
+			if !s.recorded.add(key) {
+				continue
+			}
+		}
+
+		_, err = s.eventLog.Append(ctx, &LogEntry{
+			EventId:   message.EventId,
+			Sequence:  message.Sequence,
+			Timestamp: now,
+			Payload:   payload,
+		})
+		if err != nil {
+			logrus.Errorf("record message in the event log is failed with input %v and err %v", payload, err)
+			s.recorded.forget(key)
+		}
+	}
+}
+
+// received counts the payloads a broker fetched for the targets, a payload is counted when it is fetched the first
+// time and not again when it is retried or redelivered
+func (s *syncer) received(payloads []string, targets []int) {
+	if s.metrics == nil {
+		return
+	}
+
+	for _, payload := range payloads {
+		message, err := s.decode(payload)
+		if err != nil {
+			continue
+		}
+
+		for _, idx := range targets {
+			s.metrics.messageReceived(message.Action, s.targetId(idx))
+		}
+	}
+}
+
+// eventKey identifies a recorded message, the messages of one event share its id and differ by their sequence
+func eventKey(eventId string, sequence int64) string {
+	return fmt.Sprintf("%s/%d", eventId, sequence)
+}
+
+// recordedKeys remembers the last keys recorded in the event log, the oldest one is forgotten once size are kept
+type recordedKeys struct {
+	mu    sync.Mutex
+	keys  map[string]struct{}
+	order []string
+	next  int
+}
+
+func newRecordedKeys(size int) *recordedKeys {
+	return &recordedKeys{
+		keys:  make(map[string]struct{}, size),
+		order: make([]string, size),
+	}
+}
+
+// add reports false when the key is already remembered
+func (r *recordedKeys) add(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.keys[key]; ok {
+		return false
+	}
+
+	delete(r.keys, r.order[r.next])
+	r.order[r.next] = key
+	r.next = (r.next + 1) % len(r.order)
+	r.keys[key] = struct{}{}
+
+	return true
+}
+
+func (r *recordedKeys) forget(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.keys, key)
+}
+
+// exhausted reports whether a failed payload is delivered for the last time, a payload of a broker that does not
+// redeliver is always on its last delivery and a negative maxDeliver redelivers forever
+func (s *syncer) exhausted(delivered int, redelivered bool) bool {
+	if !redelivered {
+		return true
+	}
+
+	return s.maxDeliver > 0 && delivered >= s.maxDeliver
+}
+
+// client binds the client of the target to ctx when it supports it, so the span of the sync reaches Milvus
+func (s *syncer) client(ctx context.Context, idx int) IMilvusClientInterface {
+	if contextClient, ok := s.milvus[idx].(IMilvusContextClientInterface); ok {
+		return contextClient.WithContext(ctx)
+	}
+
+	return s.milvus[idx]
+}
+
+// deadLetters counts the letters of the dead letter sink, there are none without a sink
+func (s *syncer) deadLetters(ctx context.Context) (int64, error) {
+	if s.deadLetter == nil {
+		return 0, nil
+	}
+
+	return s.deadLetter.Len(ctx)
+}
+
+// bury stores the failed payload in the dead letter sink, the payload only counts as handled once it is stored. The
+// attempts are the deliveries of the broker, or the attempts of the retry policy when the broker does not redeliver
+func (s *syncer) bury(payload string, idx int, errSync error, delivered int) bool {
+	if s.deadLetter == nil {
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	attempts := 1
+	var retryErr *RetryError
+	if errors.As(errSync, &retryErr) {
+		attempts = retryErr.Attempts
+	}
+
+	if delivered > 0 {
+		attempts = delivered
+	}
+
+	id, err := s.deadLetter.Put(ctx, &DeadLetter{
+		Payload:   payload,
+		Target:    idx,
+		Error:     errSync.Error(),
+		Attempts:  attempts,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		logrus.Errorf("put dead letter is failed with input %v, target %v and err %v", payload, idx, err)
+		return false
+	}
+
+	logrus.Warnf("message is dead lettered with id %v, input %v and target %v", id, payload, idx)
+
+	return true
+}
+
+func (s *syncer) handle(ctx context.Context, msg string, idx int) error {
+	message, err := s.decode(msg)
+	if err != nil {
+		return err
+	}
+
+	return s.sync(ctx, message, idx)
+}
+
+func (s *syncer) decode(msg string) (*MessageCDC, error) {
+	var message MessageCDC
+
+	// numbers of the scalar fields stay json.Number, a float64 would round the int64 values
+	decoder := json.NewDecoder(strings.NewReader(msg))
+	decoder.UseNumber()
+
+	err := decoder.Decode(&message)
+	if err != nil {
+		return nil, err
+	}
+
+	err = message.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+func (s *syncer) sync(ctx context.Context, message *MessageCDC, idx int) error {
+	if message == nil {
+		return fmt.Errorf("message cdc not found")
+	}
+
+	if len(s.milvus) <= idx {
+		return fmt.Errorf("milvus client not found")
+	}
+
+	client := s.client(ctx, idx)
+	message = s.mapNames(message, idx)
+
+	switch message.Action {
+	case Insert:
+		return s.insert(client, message, idx)
+	case Delete:
+		return s.delete(client, message, idx)
+	case CreateCollection:
+		return s.createCollection(client, message, idx)
+	case DropCollection:
+		return s.dropCollection(client, message, idx)
+	case CreatePartition:
+		return s.createPartition(client, message, idx)
+	case DropPartition:
+		return s.dropPartition(client, message, idx)
+	case CreateIndex:
+		return s.createIndex(client, message, idx)
+	case DropIndex:
+		return s.dropIndex(client, message, idx)
+	case LoadCollection:
+		return s.loadCollection(client, message, idx)
+	case ReleaseCollection:
+		return s.releaseCollection(client, message, idx)
+	}
+
+	return fmt.Errorf("the action is invalid")
+}
+
+// syncBatch applies the messages in order to the target, consecutive inserts or deletes of the same collection and
+// partition are merged into one request of at most batchSize messages so ordering against other actions is kept.
+// Messages already applied according to the checkpoint store or not routed to the target are skipped. The batch stops
+// at the first transient failure and the messages after it fail with errSkipped, so they are applied after it
+func (s *syncer) syncBatch(ctx context.Context, messages []*MessageCDC, idx int) []error {
+	var (
+		errs       = make([]error, len(messages))
+		checkpoint = s.newCheckpoint(idx)
+	)
+
+	for start := 0; start < len(messages); {
+		if !s.routed(messages[start], idx) {
+			s.metrics.messageFiltered(messages[start].Action, s.targetId(idx))
+			start++
+			continue
+		}
+
+		if checkpoint.applied(messages[start]) {
+			logrus.Infof("skip already applied event %v with sequence %v and target %v", messages[start].EventId, messages[start].Sequence, idx)
+			_, spans := s.startSyncSpans(ctx, messages[start:start+1], idx)
+			spans[0].SetAttributes(attribute.Bool("milvus_cdc.skipped", true))
+			endSpans(spans, nil)
+			start++
+			continue
+		}
+
+		end := start + 1
+		for end < len(messages) && end-start < s.batchSize && batchable(messages[start], messages[end]) &&
+			s.routed(messages[end], idx) && !checkpoint.applied(messages[end]) {
+			end++
+		}
+
+		var (
+			group          = messages[start:end]
+			spanCtx, spans = s.startSyncSpans(ctx, group, idx)
+			attempts       int
+		)
+
+		err := s.retryPolicy.do(ctx, func() error {
+			attempts++
+			return s.traceRPC(spanCtx, group, spans, idx, func(ctx context.Context) error {
+				if len(group) == 1 {
+					return s.sync(ctx, group[0], idx)
+				}
+
+				return s.flush(ctx, group, idx)
+			})
+		})
+
+		endSpans(spans, err)
+
+		s.metrics.retried(s.targetId(idx), attempts-1)
+		if err == nil {
+			checkpoint.commit(group)
+			s.lastApplied[idx].Store(time.Now().UnixNano())
+		} else {
+			checkpoint.fail(group)
+		}
+
+		for i := start; i < end; i++ {
+			errs[i] = err
+			if err != nil {
+				s.metrics.messageFailed(messages[i].Action, s.targetId(idx))
+			} else {
+				s.metrics.messageApplied(messages[i].Action, s.targetId(idx))
+			}
+		}
+
+		if err != nil && IsRetryable(err) {
+			for i := end; i < len(messages); i++ {
+				errs[i] = errSkipped
+			}
+
+			break
+		}
+
+		start = end
+	}
+
+	return errs
+}
+
+// routed reports whether the route of the target matches the message, a target without route receives every message
+func (s *syncer) routed(message *MessageCDC, idx int) bool {
+	route, ok := s.routes[idx]
+
+	return !ok || route.Match(message)
+}
+
+// mapNames returns the message with the collection and partition names of the target
+func (s *syncer) mapNames(message *MessageCDC, idx int) *MessageCDC {
+	mapping, ok := s.mappings[idx]
+	if !ok {
+		return message
+	}
+
+	return mapping.apply(message)
+}
+
+func batchable(first, next *MessageCDC) bool {
+	if first.Action != Insert && first.Action != Delete {
+		return false
+	}
+
+	return next.Action == first.Action &&
+		next.CollectionName == first.CollectionName &&
+		next.PartitionTag == first.PartitionTag &&
+		(len(next.Fields) == 0) == (len(first.Fields) == 0)
+}
+
+func (s *syncer) flush(ctx context.Context, messages []*MessageCDC, idx int) error {
+	if len(s.milvus) <= idx {
+		return fmt.Errorf("milvus client not found")
+	}
+
+	client := s.client(ctx, idx)
+
+	var (
+		// the messages of a batch share their collection and partition
+		first   = s.mapNames(messages[0], idx)
+		ids     []int64
+		vectors []string
+		fields  []map[string]interface{}
+	)
+
+	for _, message := range messages {
+		messageIds, messageVectors := message.Entities()
+		ids = append(ids, messageIds...)
+		vectors = append(vectors, messageVectors...)
+		for range messageIds {
+			fields = append(fields, message.Fields)
+		}
+	}
+
+	if first.Action == Delete {
+		return client.DeleteBatch(first.CollectionName, first.PartitionTag, ids)
+	}
+
+	if len(first.Fields) == 0 {
+		return client.InsertBatch(vectors, first.CollectionName, first.PartitionTag, ids)
+	}
+
+	schemaClient, ok := client.(IMilvusSchemaClientInterface)
+	if !ok {
+		return fmt.Errorf("milvus client %v does not support scalar fields", idx)
+	}
+
+	return schemaClient.InsertBatchWithFields(vectors, first.CollectionName, first.PartitionTag, ids, fields)
+}
+
+func (s *syncer) insert(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	ids, vectors := cdc.Entities()
+	if len(ids) > 1 {
+		return client.InsertBatch(vectors, cdc.CollectionName, cdc.PartitionTag, ids)
+	}
+
+	if len(cdc.Fields) == 0 {
+		return client.Insert(vectors[0], cdc.CollectionName, cdc.PartitionTag, ids[0])
+	}
+
+	schemaClient, ok := client.(IMilvusSchemaClientInterface)
+	if !ok {
+		return fmt.Errorf("milvus client %v does not support scalar fields", idx)
+	}
+
+	return schemaClient.InsertWithFields(vectors[0], cdc.CollectionName, cdc.PartitionTag, ids[0], cdc.Fields)
+}
+
+func (s *syncer) delete(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	ids, _ := cdc.Entities()
+	if len(ids) > 1 {
+		return client.DeleteBatch(cdc.CollectionName, cdc.PartitionTag, ids)
+	}
+
+	return client.Delete(cdc.CollectionName, cdc.PartitionTag, ids[0])
+}
+
+func (s *syncer) dropCollection(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	return client.DropCollection(cdc.CollectionName)
+}
+
+func (s *syncer) createCollection(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	if len(cdc.Schema) == 0 {
+		return client.CreateCollection(cdc.CollectionName, cdc.Dimension, cdc.IndexFileSize, cdc.MetricType)
+	}
+
+	schemaClient, ok := client.(IMilvusSchemaClientInterface)
+	if !ok {
+		return fmt.Errorf("milvus client %v does not support scalar fields", idx)
+	}
+
+	return schemaClient.CreateCollectionWithSchema(cdc.CollectionName, cdc.Dimension, cdc.MetricType, cdc.Schema)
+}
+
+func (s *syncer) createIndex(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	return client.CreateIndex(cdc.CollectionName, cdc.NList, cdc.IndexType)
+}
+
+func (s *syncer) dropIndex(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	return client.DropIndex(cdc.CollectionName)
+}
+
+func (s *syncer) createPartition(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	return client.CreatePartition(cdc.CollectionName, cdc.PartitionTag)
+}
+
+func (s *syncer) dropPartition(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	return client.DropPartition(cdc.CollectionName, cdc.PartitionTag)
+}
+
+func (s *syncer) loadCollection(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	return client.LoadCollection(cdc.CollectionName)
+}
+
+func (s *syncer) releaseCollection(client IMilvusClientInterface, cdc *MessageCDC, idx int) error {
+	return client.ReleaseCollection(cdc.CollectionName)
+}
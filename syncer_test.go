@@ -0,0 +1,138 @@
+package milvus_cdc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestSyncerExhausted(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxDeliver  int
+		delivered   int
+		redelivered bool
+		exhausted   bool
+	}{
+		{name: "no redelivery", maxDeliver: 5, delivered: 0, redelivered: false, exhausted: true},
+		{name: "first delivery", maxDeliver: 5, delivered: 1, redelivered: true, exhausted: false},
+		{name: "last delivery", maxDeliver: 5, delivered: 5, redelivered: true, exhausted: true},
+		{name: "unlimited", maxDeliver: -1, delivered: 100, redelivered: true, exhausted: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &syncer{maxDeliver: test.maxDeliver}
+			if got := s.exhausted(test.delivered, test.redelivered); got != test.exhausted {
+				t.Fatalf("exhausted is %v, wanted %v", got, test.exhausted)
+			}
+		})
+	}
+}
+
+func TestSyncBatchStopsAtTheFirstTransientFailure(t *testing.T) {
+	target := &fakeMilvus{}
+	target.setFail(func(call fakeCall) error {
+		if call.CollectionName == "c" {
+			return errUnavailable
+		}
+
+		return nil
+	})
+
+	s := newSyncer([]IMilvusClientInterface{target}, newOptions())
+	messages := []*MessageCDC{
+		{Action: Insert, CollectionName: "c", Id: 1, Vector: EncodeVector([]float32{1})},
+		{Action: Insert, CollectionName: "d", Id: 2, Vector: EncodeVector([]float32{2})},
+		{Action: Insert, CollectionName: "d", Id: 3, Vector: EncodeVector([]float32{3})},
+	}
+
+	errs := s.syncBatch(context.Background(), messages, 0)
+	if !errors.Is(errs[0], errUnavailable) || errs[1] != errSkipped || errs[2] != errSkipped {
+		t.Fatalf("the errors of the batch are %v", errs)
+	}
+
+	if calls := target.Calls(); len(calls) > 0 {
+		t.Fatalf("the messages after the failure are applied with the calls %v", calls)
+	}
+}
+
+func TestHandleBatchDoesNotBurySkippedMessages(t *testing.T) {
+	_, client := newTestRedis(t)
+	target := &fakeMilvus{}
+	target.setFail(func(call fakeCall) error {
+		if call.CollectionName == "c" {
+			return errUnavailable
+		}
+
+		return nil
+	})
+
+	sink := NewRedisDeadLetterSink(client, "dlq")
+	s := newSyncer([]IMilvusClientInterface{target}, newOptions(WithDeadLetterSink(sink), WithMaxDeliver(2)))
+
+	failed, _ := s.handleDelivered(context.Background(), []string{insertPayload(t, "c", 1, 0), insertPayload(t, "d", 2, 0)},
+		[]int{2, 2}, []int{0})
+	if len(failed[0]) != 0 || !slices.Equal(failed[1], []int{0}) {
+		t.Fatalf("the failed targets are %v, wanted the exhausted message buried and the next one failed", failed)
+	}
+
+	if size, _ := sink.Len(context.Background()); size != 1 {
+		t.Fatalf("the sink holds %d dead letters, wanted 1", size)
+	}
+}
+
+// contextMilvus records the contexts the syncer binds the target to
+type contextMilvus struct {
+	*fakeMilvus
+
+	mu   sync.Mutex
+	ctxs []context.Context
+}
+
+func (c *contextMilvus) WithContext(ctx context.Context) IMilvusClientInterface {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ctxs = append(c.ctxs, ctx)
+
+	return c.fakeMilvus
+}
+
+func TestSyncBatchRunsTheRPCsUnderTheSpanOfTheSync(t *testing.T) {
+	target := &contextMilvus{fakeMilvus: &fakeMilvus{}}
+	provider := newRecordingProvider()
+	s := newSyncer([]IMilvusClientInterface{target}, newOptions(WithTracerProvider(provider)))
+
+	message := &MessageCDC{
+		Action:         Insert,
+		CollectionName: "c",
+		Id:             1,
+		Vector:         EncodeVector([]float32{1}),
+		TraceParent:    "00-cafe0000000000000000000000000000-beef000000000000-01",
+	}
+
+	errs := s.syncBatch(context.Background(), []*MessageCDC{message}, 0)
+	if errs[0] != nil {
+		t.Fatalf("sync is failed with err %v", errs[0])
+	}
+
+	if len(target.ctxs) != 1 {
+		t.Fatalf("the target is bound %d times, wanted once", len(target.ctxs))
+	}
+
+	// the rpc runs in the span of the milvus call, a child of the sync span continuing the trace of the producer
+	span := trace.SpanContextFromContext(target.ctxs[0])
+	if span.TraceID().String() != "cafe0000000000000000000000000000" {
+		t.Fatalf("the rpc runs in the trace %v, wanted the trace of the message", span.TraceID())
+	}
+
+	if names := provider.tracer.Names(); !slices.Equal(names, []string{"milvus-cdc.sync", "milvus-cdc.milvus." + Insert}) ||
+		span.SpanID() != (trace.SpanID{2}) {
+		t.Fatalf("the rpc runs in the span %v, the spans are %v", span.SpanID(), names)
+	}
+}
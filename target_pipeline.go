@@ -0,0 +1,256 @@
+package milvus_cdc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/sirupsen/logrus"
+)
+
+type TargetStatus struct {
+	Target     int   `json:"target"`
+	Healthy    bool  `json:"healthy"`
+	CatchingUp bool  `json:"catching_up"`
+	Lag        int64 `json:"lag"`
+}
+
+// targetPipeline applies the messages of one target from its own durable queue, so a slow or unavailable target
+// only delays itself. Messages being applied are kept in a processing queue until they are done
+type targetPipeline struct {
+	idx        int
+	queue      string
+	processing string
+	redisCli   *RedisClient
+	syncer     *syncer
+	opts       *options
+	mu         sync.RWMutex
+	status     TargetStatus
+	reachable  bool
+}
+
+// newTargetPipeline names the queue after the target id, the messages already queued follow their target when the
+// targets are reordered
+func newTargetPipeline(idx int, channel string, redisCli *RedisClient, syncer *syncer, opts *options) *targetPipeline {
+	queue := fmt.Sprintf("%s:target:%s", channel, syncer.targetId(idx))
+
+	return &targetPipeline{
+		idx:        idx,
+		queue:      queue,
+		processing: queue + ":processing",
+		redisCli:   redisCli,
+		syncer:     syncer,
+		opts:       opts,
+		status: TargetStatus{
+			Target:  idx,
+			Healthy: true,
+		},
+		reachable: true,
+	}
+}
+
+func (p *targetPipeline) Status() TargetStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.status
+}
+
+// run applies the queue until the broker stops consuming, the batch being applied is finished first
+func (p *targetPipeline) run(l *lifecycle) {
+	ctx := l.work()
+	p.recover(ctx)
+
+	for !l.stopping() {
+		p.refresh(ctx)
+
+		status := p.Status()
+		if !status.Healthy && !p.probe() {
+			sleep(l.consuming(), p.opts.retryDelay)
+			continue
+		}
+
+		size := p.opts.batchSize
+		if status.CatchingUp && size < DefaultCatchUpBatchSize {
+			size = DefaultCatchUpBatchSize
+		}
+
+		// a pop that is aborted halfway leaves the message in the processing queue, so it is recovered on restart
+		batch := p.pop(l.consuming(), size)
+		if len(batch) > 0 {
+			l.begin(len(batch))
+			p.apply(ctx, batch)
+			l.end(len(batch))
+		}
+	}
+}
+
+// recover moves the messages left in the processing queue by a previous run back to the tail of the queue, the
+// oldest message ends up last so it is applied first
+func (p *targetPipeline) recover(ctx context.Context) {
+	for {
+		_, err := p.redisCli.LMove(ctx, p.processing, p.queue, "LEFT", "RIGHT")
+		if err != nil {
+			if err != redis.Nil && ctx.Err() == nil {
+				logrus.Errorf("recover processing queue %v is failed with err %v", p.processing, err)
+			}
+
+			return
+		}
+	}
+}
+
+// refresh updates the lag of the target, a target lagging more than maxLag is marked unhealthy and catches up
+// until its lag is back under maxLag
+func (p *targetPipeline) refresh(ctx context.Context) {
+	queued, err := p.redisCli.LLen(ctx, p.queue)
+	if err != nil {
+		return
+	}
+
+	processing, err := p.redisCli.LLen(ctx, p.processing)
+	if err != nil {
+		return
+	}
+
+	p.syncer.metrics.setQueueDepth(p.queue, queued)
+	p.syncer.metrics.setLag(p.syncer.targetId(p.idx), queued+processing)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.status.Lag = queued + processing
+	if p.status.Lag > p.opts.maxLag {
+		if p.status.Healthy {
+			logrus.Warnf("target %v lags %v messages behind, mark it unhealthy", p.idx, p.status.Lag)
+		}
+
+		p.status.Healthy = false
+		p.status.CatchingUp = true
+
+		return
+	}
+
+	if !p.reachable {
+		return
+	}
+
+	p.status.Healthy = true
+	if p.status.Lag == 0 && p.status.CatchingUp {
+		logrus.Infof("target %v caught up", p.idx)
+		p.status.CatchingUp = false
+	}
+}
+
+// probe checks whether an unhealthy target is reachable, once it is it catches up with the messages it missed
+func (p *targetPipeline) probe() bool {
+	p.mu.RLock()
+	reachable := p.reachable
+	p.mu.RUnlock()
+
+	if reachable {
+		return true
+	}
+
+	if !p.syncer.milvus[p.idx].IsConnected() {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.reachable = true
+	p.status.CatchingUp = true
+	logrus.Infof("target %v is reachable, catch up %v messages", p.idx, p.status.Lag)
+
+	return true
+}
+
+func (p *targetPipeline) markUnreachable(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.reachable {
+		logrus.Warnf("target %v is failed with err %v, mark it unhealthy", p.idx, err)
+	}
+
+	p.reachable = false
+	p.status.Healthy = false
+}
+
+func (p *targetPipeline) pop(ctx context.Context, size int) []string {
+	payload, err := p.redisCli.BRPopLPush(ctx, p.queue, p.processing, p.opts.readBlock)
+	if err != nil {
+		if err != redis.Nil && ctx.Err() == nil {
+			logrus.Errorf("pop queue %v is failed with err %v", p.queue, err)
+			sleep(ctx, p.opts.retryDelay)
+		}
+
+		return nil
+	}
+
+	batch := []string{payload}
+	for len(batch) < size {
+		payload, err = p.redisCli.RPopLPush(ctx, p.queue, p.processing)
+		if err != nil {
+			break
+		}
+
+		batch = append(batch, payload)
+	}
+
+	return batch
+}
+
+func (p *targetPipeline) apply(ctx context.Context, batch []string) {
+	var (
+		messages = make([]*MessageCDC, 0, len(batch))
+		payloads = make([]string, 0, len(batch))
+	)
+
+	for _, payload := range batch {
+		message, err := p.syncer.decode(payload)
+		if err != nil {
+			logrus.Errorf("handle message is failed with input %v and err %v", payload, err)
+			p.done(ctx, payload)
+			continue
+		}
+
+		messages = append(messages, message)
+		payloads = append(payloads, payload)
+	}
+
+	var requeue []string
+	for i, err := range p.syncer.syncBatch(ctx, messages, p.idx) {
+		switch {
+		case err == nil:
+			logrus.Infof("handle message is successfully with input %v and target %v", payloads[i], p.idx)
+		case IsRetryable(err):
+			// the target is probably down, keep the message so it is applied once the target recovers
+			p.markUnreachable(err)
+			requeue = append(requeue, payloads[i])
+			continue
+		default:
+			logrus.Errorf("handle message is failed with input %v, target %v and err %v", payloads[i], p.idx, err)
+			p.syncer.bury(payloads[i], p.idx, err, 0)
+		}
+
+		p.done(ctx, payloads[i])
+	}
+
+	// the newest message is moved first so the oldest one ends up at the tail and is popped first
+	for i := len(requeue) - 1; i >= 0; i-- {
+		_, err := p.redisCli.LMove(ctx, p.processing, p.queue, "LEFT", "RIGHT")
+		if err != nil {
+			logrus.Errorf("requeue message %v is failed with err %v", requeue[i], err)
+		}
+	}
+}
+
+func (p *targetPipeline) done(ctx context.Context, payload string) {
+	_, err := p.redisCli.LRem(ctx, p.processing, -1, payload)
+	if err != nil {
+		logrus.Errorf("remove message %v from processing queue is failed with err %v", payload, err)
+	}
+}
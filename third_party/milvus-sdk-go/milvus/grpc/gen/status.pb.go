@@ -0,0 +1,307 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.9.0
+// source: milvus/grpc/status.proto
+
+package gen
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ErrorCode int32
+
+const (
+	ErrorCode_SUCCESS                 ErrorCode = 0
+	ErrorCode_UNEXPECTED_ERROR        ErrorCode = 1
+	ErrorCode_CONNECT_FAILED          ErrorCode = 2
+	ErrorCode_PERMISSION_DENIED       ErrorCode = 3
+	ErrorCode_COLLECTION_NOT_EXISTS   ErrorCode = 4
+	ErrorCode_ILLEGAL_ARGUMENT        ErrorCode = 5
+	ErrorCode_ILLEGAL_DIMENSION       ErrorCode = 7
+	ErrorCode_ILLEGAL_INDEX_TYPE      ErrorCode = 8
+	ErrorCode_ILLEGAL_COLLECTION_NAME ErrorCode = 9
+	ErrorCode_ILLEGAL_TOPK            ErrorCode = 10
+	ErrorCode_ILLEGAL_ROWRECORD       ErrorCode = 11
+	ErrorCode_ILLEGAL_VECTOR_ID       ErrorCode = 12
+	ErrorCode_ILLEGAL_SEARCH_RESULT   ErrorCode = 13
+	ErrorCode_FILE_NOT_FOUND          ErrorCode = 14
+	ErrorCode_META_FAILED             ErrorCode = 15
+	ErrorCode_CACHE_FAILED            ErrorCode = 16
+	ErrorCode_CANNOT_CREATE_FOLDER    ErrorCode = 17
+	ErrorCode_CANNOT_CREATE_FILE      ErrorCode = 18
+	ErrorCode_CANNOT_DELETE_FOLDER    ErrorCode = 19
+	ErrorCode_CANNOT_DELETE_FILE      ErrorCode = 20
+	ErrorCode_BUILD_INDEX_ERROR       ErrorCode = 21
+	ErrorCode_ILLEGAL_NLIST           ErrorCode = 22
+	ErrorCode_ILLEGAL_METRIC_TYPE     ErrorCode = 23
+	ErrorCode_OUT_OF_MEMORY           ErrorCode = 24
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "SUCCESS",
+		1:  "UNEXPECTED_ERROR",
+		2:  "CONNECT_FAILED",
+		3:  "PERMISSION_DENIED",
+		4:  "COLLECTION_NOT_EXISTS",
+		5:  "ILLEGAL_ARGUMENT",
+		7:  "ILLEGAL_DIMENSION",
+		8:  "ILLEGAL_INDEX_TYPE",
+		9:  "ILLEGAL_COLLECTION_NAME",
+		10: "ILLEGAL_TOPK",
+		11: "ILLEGAL_ROWRECORD",
+		12: "ILLEGAL_VECTOR_ID",
+		13: "ILLEGAL_SEARCH_RESULT",
+		14: "FILE_NOT_FOUND",
+		15: "META_FAILED",
+		16: "CACHE_FAILED",
+		17: "CANNOT_CREATE_FOLDER",
+		18: "CANNOT_CREATE_FILE",
+		19: "CANNOT_DELETE_FOLDER",
+		20: "CANNOT_DELETE_FILE",
+		21: "BUILD_INDEX_ERROR",
+		22: "ILLEGAL_NLIST",
+		23: "ILLEGAL_METRIC_TYPE",
+		24: "OUT_OF_MEMORY",
+	}
+	ErrorCode_value = map[string]int32{
+		"SUCCESS":                 0,
+		"UNEXPECTED_ERROR":        1,
+		"CONNECT_FAILED":          2,
+		"PERMISSION_DENIED":       3,
+		"COLLECTION_NOT_EXISTS":   4,
+		"ILLEGAL_ARGUMENT":        5,
+		"ILLEGAL_DIMENSION":       7,
+		"ILLEGAL_INDEX_TYPE":      8,
+		"ILLEGAL_COLLECTION_NAME": 9,
+		"ILLEGAL_TOPK":            10,
+		"ILLEGAL_ROWRECORD":       11,
+		"ILLEGAL_VECTOR_ID":       12,
+		"ILLEGAL_SEARCH_RESULT":   13,
+		"FILE_NOT_FOUND":          14,
+		"META_FAILED":             15,
+		"CACHE_FAILED":            16,
+		"CANNOT_CREATE_FOLDER":    17,
+		"CANNOT_CREATE_FILE":      18,
+		"CANNOT_DELETE_FOLDER":    19,
+		"CANNOT_DELETE_FILE":      20,
+		"BUILD_INDEX_ERROR":       21,
+		"ILLEGAL_NLIST":           22,
+		"ILLEGAL_METRIC_TYPE":     23,
+		"OUT_OF_MEMORY":           24,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_status_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_status_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_status_proto_rawDescGZIP(), []int{0}
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=milvus.grpc.ErrorCode" json:"error_code,omitempty"`
+	Reason    string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_status_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_status_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_status_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Status) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_SUCCESS
+}
+
+func (x *Status) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_status_proto protoreflect.FileDescriptor
+
+var file_status_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x22, 0x57, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x35, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x2a, 0xa2, 0x04, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b,
+	0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55,
+	0x4e, 0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15,
+	0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x45,
+	0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4c, 0x4c, 0x45, 0x47,
+	0x41, 0x4c, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x05, 0x12, 0x15, 0x0a,
+	0x11, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x44, 0x49, 0x4d, 0x45, 0x4e, 0x53, 0x49,
+	0x4f, 0x4e, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f,
+	0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x08, 0x12, 0x1b, 0x0a, 0x17,
+	0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4c, 0x4c, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x09, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4c, 0x4c,
+	0x45, 0x47, 0x41, 0x4c, 0x5f, 0x54, 0x4f, 0x50, 0x4b, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x49,
+	0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x52, 0x4f, 0x57, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44,
+	0x10, 0x0b, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41, 0x4c, 0x5f, 0x56, 0x45,
+	0x43, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x44, 0x10, 0x0c, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x4c, 0x4c,
+	0x45, 0x47, 0x41, 0x4c, 0x5f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x55,
+	0x4c, 0x54, 0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x0e, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x45, 0x54, 0x41,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41, 0x43,
+	0x48, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x10, 0x12, 0x18, 0x0a, 0x14, 0x43,
+	0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x4f, 0x4c,
+	0x44, 0x45, 0x52, 0x10, 0x11, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x12, 0x12, 0x18, 0x0a,
+	0x14, 0x43, 0x41, 0x4e, 0x4e, 0x4f, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x46,
+	0x4f, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x13, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x41, 0x4e, 0x4e, 0x4f,
+	0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x14, 0x12,
+	0x15, 0x0a, 0x11, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x10, 0x15, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4c, 0x4c, 0x45, 0x47, 0x41,
+	0x4c, 0x5f, 0x4e, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x16, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4c, 0x4c,
+	0x45, 0x47, 0x41, 0x4c, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x10, 0x17, 0x12, 0x11, 0x0a, 0x0d, 0x4f, 0x55, 0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x4d, 0x45, 0x4d,
+	0x4f, 0x52, 0x59, 0x10, 0x18, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2d, 0x69, 0x6f, 0x2f, 0x6d, 0x69,
+	0x6c, 0x76, 0x75, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2d, 0x67, 0x6f, 0x2f, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_status_proto_rawDescOnce sync.Once
+	file_status_proto_rawDescData = file_status_proto_rawDesc
+)
+
+func file_status_proto_rawDescGZIP() []byte {
+	file_status_proto_rawDescOnce.Do(func() {
+		file_status_proto_rawDescData = protoimpl.X.CompressGZIP(file_status_proto_rawDescData)
+	})
+	return file_status_proto_rawDescData
+}
+
+var file_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_status_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_status_proto_goTypes = []interface{}{
+	(ErrorCode)(0), // 0: milvus.grpc.ErrorCode
+	(*Status)(nil), // 1: milvus.grpc.Status
+}
+var file_status_proto_depIdxs = []int32{
+	0, // 0: milvus.grpc.Status.error_code:type_name -> milvus.grpc.ErrorCode
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_status_proto_init() }
+func file_status_proto_init() {
+	if File_status_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_status_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_status_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_status_proto_goTypes,
+		DependencyIndexes: file_status_proto_depIdxs,
+		EnumInfos:         file_status_proto_enumTypes,
+		MessageInfos:      file_status_proto_msgTypes,
+	}.Build()
+	File_status_proto = out.File
+	file_status_proto_rawDesc = nil
+	file_status_proto_goTypes = nil
+	file_status_proto_depIdxs = nil
+}
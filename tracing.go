@@ -0,0 +1,182 @@
+package milvus_cdc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// traceContext propagates spans inside MessageCDC with the W3C traceparent and tracestate fields
+var traceContext = propagation.TraceContext{}
+
+// messageCarrier exposes the trace fields of a message as a propagation.TextMapCarrier
+type messageCarrier struct {
+	message *MessageCDC
+}
+
+func (c messageCarrier) Get(key string) string {
+	switch key {
+	case TraceParentHeader:
+		return c.message.TraceParent
+	case TraceStateHeader:
+		return c.message.TraceState
+	}
+
+	return ""
+}
+
+func (c messageCarrier) Set(key, value string) {
+	switch key {
+	case TraceParentHeader:
+		c.message.TraceParent = value
+	case TraceStateHeader:
+		c.message.TraceState = value
+	}
+}
+
+func (c messageCarrier) Keys() []string {
+	return []string{TraceParentHeader, TraceStateHeader}
+}
+
+// metadataCarrier exposes the outgoing grpc metadata of an RPC as a propagation.TextMapCarrier
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// tracingInterceptor runs every unary RPC to Milvus in a client span and sends its trace context in the metadata, so
+// Milvus continues the trace of the sync
+func tracingInterceptor(provider trace.TracerProvider) grpc.UnaryClientInterceptor {
+	tracer := provider.Tracer(TracerName)
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, span := tracer.Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attribute.String("rpc.system", "grpc"), attribute.String("rpc.method", method)),
+		)
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		md = md.Copy()
+		traceContext.Inject(ctx, metadataCarrier(md))
+
+		err := invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+		endSpan(span, err)
+
+		return err
+	}
+}
+
+// InjectTrace stores the span of ctx in the message, producers call it before publishing so the sync of the message
+// continues their trace
+func (m *MessageCDC) InjectTrace(ctx context.Context) {
+	traceContext.Inject(ctx, messageCarrier{message: m})
+}
+
+// ExtractTrace returns ctx with the span stored in the message as remote parent
+func (m *MessageCDC) ExtractTrace(ctx context.Context) context.Context {
+	return traceContext.Extract(ctx, messageCarrier{message: m})
+}
+
+// tracer uses the tracer provider of the options, or the global one which records nothing until it is set
+func (o *options) tracer() trace.Tracer {
+	provider := o.tracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	return provider.Tracer(TracerName)
+}
+
+func messageAttributes(message *MessageCDC, idx int) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("milvus_cdc.action", message.Action),
+		attribute.String("milvus_cdc.collection", message.CollectionName),
+		attribute.String("milvus_cdc.partition", message.PartitionTag),
+		attribute.Int("milvus_cdc.target", idx),
+		attribute.String("milvus_cdc.event_id", message.EventId),
+	}
+}
+
+// startSyncSpans starts one span per message continuing the trace of its producer, the returned context carries the
+// span of the first message so the Milvus call of the group is its child and links to the other messages
+func (s *syncer) startSyncSpans(ctx context.Context, group []*MessageCDC, idx int) (context.Context, []trace.Span) {
+	var (
+		groupCtx context.Context
+		spans    = make([]trace.Span, 0, len(group))
+	)
+
+	for _, message := range group {
+		spanCtx, span := s.tracer.Start(message.ExtractTrace(ctx), "milvus-cdc.sync",
+			trace.WithSpanKind(trace.SpanKindConsumer),
+			trace.WithAttributes(messageAttributes(message, idx)...),
+		)
+
+		if groupCtx == nil {
+			groupCtx = spanCtx
+		}
+
+		spans = append(spans, span)
+	}
+
+	return groupCtx, spans
+}
+
+func endSpans(spans []trace.Span, err error) {
+	for _, span := range spans {
+		endSpan(span, err)
+	}
+}
+
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// traceRPC runs the Milvus call of the group in a client span, every attempt of a retry gets its own span. fn gets the
+// context of the span so the RPCs of the call are its children
+func (s *syncer) traceRPC(ctx context.Context, group []*MessageCDC, spans []trace.Span, idx int, fn func(ctx context.Context) error) error {
+	links := make([]trace.Link, 0, len(spans))
+	for _, span := range spans[1:] {
+		links = append(links, trace.Link{SpanContext: span.SpanContext()})
+	}
+
+	rpcCtx, span := s.tracer.Start(ctx, "milvus-cdc.milvus."+group[0].Action,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithLinks(links...),
+		trace.WithAttributes(append(messageAttributes(group[0], idx), attribute.Int("milvus_cdc.batch_size", len(group)))...),
+	)
+
+	err := fn(rpcCtx)
+	endSpan(span, err)
+
+	return err
+}
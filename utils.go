@@ -1,7 +1,72 @@
 package milvus_cdc
 
-import "unsafe"
+import (
+	"context"
+	"encoding/hex"
+	"time"
+	"unsafe"
+)
 
 func DecodeUnsafeF32(bs []byte) []float32 {
 	return unsafe.Slice((*float32)(unsafe.Pointer(&bs[0])), len(bs)/4)
 }
+
+func EncodeUnsafeF32(fs []float32) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(&fs[0])), len(fs)*4)
+}
+
+// EncodeVector hex encodes the float32 of a vector the way MessageCDC carries them
+func EncodeVector(vector []float32) string {
+	if len(vector) == 0 {
+		return ""
+	}
+
+	return hex.EncodeToString(EncodeUnsafeF32(vector))
+}
+
+// collect blocks for the first item and then gathers more until the batch is full or the latency is reached,
+// it returns nil once the context is done or the channel is closed
+func collect[T any](ctx context.Context, in <-chan T, size int, latency time.Duration) []T {
+	var batch []T
+	select {
+	case <-ctx.Done():
+		return nil
+	case item, ok := <-in:
+		if !ok {
+			return nil
+		}
+
+		batch = append(batch, item)
+	}
+
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+
+	for len(batch) < size {
+		select {
+		case <-ctx.Done():
+			return batch
+		case <-timer.C:
+			return batch
+		case item, ok := <-in:
+			if !ok {
+				return batch
+			}
+
+			batch = append(batch, item)
+		}
+	}
+
+	return batch
+}
+
+func identity(payload string) string {
+	return payload
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(d):
+	}
+}
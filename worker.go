@@ -1,13 +1,78 @@
 package milvus_cdc
 
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Subscription struct {
+	Broker  string
+	Channel string
+	Pattern string
+}
+
+type WorkerOption func(*WorkerCDC)
+
 type WorkerCDC struct {
 	brokerFactory *BrokerFactory
+	metricsAddr   string
+	metrics       *Metrics
+	healthAddr    string
+	healthOpts    []HealthOption
+	mu            sync.Mutex
+	running       int
+	startedMu     sync.Mutex
+	started       map[string]IBrokerFactory
+	server        *http.Server
+	health        *healthServer
 }
 
-func NewWorkerCDC(brokerFactory *BrokerFactory) *WorkerCDC {
-	return &WorkerCDC{
+func NewWorkerCDC(brokerFactory *BrokerFactory, opts ...WorkerOption) *WorkerCDC {
+	w := &WorkerCDC{
 		brokerFactory: brokerFactory,
+		started:       make(map[string]IBrokerFactory),
+	}
+
+	for _, opt := range opts {
+		opt(w)
 	}
+
+	return w
+}
+
+// WithMetricsServer serves the metrics on addr under /metrics while the worker runs at least one subscription
+func WithMetricsServer(addr string, metrics *Metrics) WorkerOption {
+	return func(w *WorkerCDC) {
+		w.metricsAddr = addr
+		w.metrics = metrics
+	}
+}
+
+// WithHealthServer serves /healthz and /readyz on addr while the worker runs at least one subscription. Liveness fails
+// when a consumer loop of a started broker returned, readiness also fails when a broker connection is lost or a
+// required target is down longer than the down threshold
+func WithHealthServer(addr string, opts ...HealthOption) WorkerOption {
+	return func(w *WorkerCDC) {
+		w.healthAddr = addr
+		w.healthOpts = opts
+	}
+}
+
+// Health returns the last report of the health server, it is empty when the health server is not running
+func (w *WorkerCDC) Health() HealthReport {
+	w.mu.Lock()
+	health := w.health
+	w.mu.Unlock()
+
+	if health == nil {
+		return HealthReport{}
+	}
+
+	return health.Report()
 }
 
 func (w *WorkerCDC) Start(broker, channel, pattern string) error {
@@ -16,15 +81,138 @@ func (w *WorkerCDC) Start(broker, channel, pattern string) error {
 		return err
 	}
 
+	w.acquire(broker, processor)
+	defer w.release()
+
 	return processor.Start(channel, pattern)
 }
 
-func (w *WorkerCDC) Stop(broker string) error {
+// StartAll runs every subscription concurrently and blocks until all of them are stopped
+func (w *WorkerCDC) StartAll(subscriptions ...Subscription) error {
+	for _, subscription := range subscriptions {
+		_, err := w.brokerFactory.GetBrokerFactory(subscription.Broker)
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	for _, subscription := range subscriptions {
+		wg.Add(1)
+		go func(s Subscription) {
+			defer wg.Done()
+			err := w.Start(s.Broker, s.Channel, s.Pattern)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(subscription)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// acquire starts the metrics and health servers with the first running subscription
+func (w *WorkerCDC) acquire(broker string, processor IBrokerFactory) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.startedMu.Lock()
+	w.started[broker] = processor
+	w.startedMu.Unlock()
+
+	w.running++
+	if w.running > 1 {
+		return
+	}
+
+	if w.healthAddr != "" {
+		w.health = newHealthServer(w.healthAddr, w.startedBrokers, w.healthOpts...)
+		w.health.start()
+	}
+
+	if w.metrics == nil || w.metricsAddr == "" {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", w.metrics.Handler())
+	w.server = &http.Server{
+		Addr:    w.metricsAddr,
+		Handler: mux,
+	}
+
+	go func(server *http.Server) {
+		err := server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logrus.Errorf("serve metrics on %v is failed with err %v", server.Addr, err)
+		}
+	}(w.server)
+}
+
+// release shuts the metrics and health servers down once the last subscription returned
+func (w *WorkerCDC) release() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.running--
+	if w.running > 0 {
+		return
+	}
+
+	w.startedMu.Lock()
+	w.started = make(map[string]IBrokerFactory)
+	w.startedMu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultTimeout)
+	defer cancel()
+
+	if w.health != nil {
+		err := w.health.stop(ctx)
+		if err != nil {
+			logrus.Errorf("shutdown health server is failed with err %v", err)
+		}
+
+		w.health = nil
+	}
+
+	if w.server != nil {
+		err := w.server.Shutdown(ctx)
+		if err != nil {
+			logrus.Errorf("shutdown metrics server is failed with err %v", err)
+		}
+
+		w.server = nil
+	}
+}
+
+// startedBrokers returns the brokers running a subscription, the health server only checks those
+func (w *WorkerCDC) startedBrokers() map[string]IBrokerFactory {
+	w.startedMu.Lock()
+	defer w.startedMu.Unlock()
+
+	brokers := make(map[string]IBrokerFactory, len(w.started))
+	for name, broker := range w.started {
+		brokers[name] = broker
+	}
+
+	return brokers
+}
+
+// Stop stops every subscription of the broker and waits until ctx is done for the messages in flight
+func (w *WorkerCDC) Stop(ctx context.Context, broker string) error {
 	processor, err := w.brokerFactory.GetBrokerFactory(broker)
 	if err != nil {
 		return err
 	}
 
-	processor.Stop()
-	return nil
+	return processor.Stop(ctx)
 }